@@ -0,0 +1,81 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+// A structured logging field.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// The logger used throughout the SDK. The SDK only emits debug messages.
+type Logger interface {
+	Debug(msg string, fields ...Field)
+}
+
+func Any(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+func Err(err error) Field {
+	return Field{Key: "error", Value: err}
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...Field) {}
+
+// Returns a Logger that discards every message.
+func Nop() Logger {
+	return nopLogger{}
+}
+
+type zapLogger struct {
+	l *zap.Logger
+}
+
+// Returns a Logger that writes to a zap Logger.
+func NewZap(l *zap.Logger) Logger {
+	if l == nil {
+		return Nop()
+	}
+	return zapLogger{l: l}
+}
+
+func (z zapLogger) Debug(msg string, fields ...Field) {
+	zf := make([]zap.Field, 0, len(fields))
+	for _, f := range fields {
+		zf = append(zf, zap.Any(f.Key, f.Value))
+	}
+	z.l.Debug(msg, zf...)
+}
+
+type slogLogger struct {
+	l *slog.Logger
+}
+
+// Returns a Logger that writes to a slog Logger.
+func NewSlog(l *slog.Logger) Logger {
+	if l == nil {
+		return Nop()
+	}
+	return slogLogger{l: l}
+}
+
+func (s slogLogger) Debug(msg string, fields ...Field) {
+	ctx := context.Background()
+	if !s.l.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	attrs := make([]slog.Attr, 0, len(fields))
+	for _, f := range fields {
+		attrs = append(attrs, slog.Any(f.Key, f.Value))
+	}
+	s.l.LogAttrs(ctx, slog.LevelDebug, msg, attrs...)
+}
@@ -0,0 +1,289 @@
+package paginate
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"strconv"
+)
+
+// Fetches the raw JSON body of a single page of results. The params are the query parameters
+// for the page being requested.
+type Fetcher func(ctx context.Context, params map[string]any) ([]byte, error)
+
+// Decodes the results and pagination metadata from the raw JSON body of a single page.
+type Extractor[T any] func(body []byte) ([]T, Meta, error)
+
+// A single page of results, as returned by the API.
+type Page[T any] struct {
+	Items []T
+	Meta  Meta
+	// The query parameters the page was requested with.
+	Params map[string]any
+	// The number of results skipped from the start of the page when resuming a listing.
+	Offset int
+}
+
+// A point in a listing that iteration can be resumed from: the page holding the next result,
+// and how many results of that page have already been returned.
+type Position struct {
+	Params map[string]any `json:"params,omitempty"`
+	Offset int            `json:"offset,omitempty"`
+}
+
+// A paginated List endpoint. Pages are fetched lazily: nothing is requested until one of the
+// iterators is consumed, and no further pages are requested once the consumer stops.
+type List[T any] struct {
+	Fetch   Fetcher
+	Extract Extractor[T]
+	// The query parameters for the first page. Subsequent pages use the parameters from the
+	// next_page URL returned by the API.
+	Params map[string]any
+	// The maximum number of results to return across all pages. Zero means no limit.
+	MaxResults int
+	// The number of pages to fetch concurrently once the first page has reported the total
+	// number of pages. Values below 2 fetch pages one at a time.
+	Prefetch int
+	// Optional hook that returns the Position to resume from when iteration starts. MaxResults
+	// counts only the results returned after that point.
+	Resume func() (Position, bool)
+	// Optional hook that All calls with the Position just past each result, before the result
+	// is yielded.
+	Track func(Position)
+	// Optional hook that All calls with the number of results in each page, before the first of
+	// them is yielded.
+	PageStart func(n int)
+	// Optional hook for debug messages.
+	Debug func(msg string)
+}
+
+// Returns an Extractor for list responses that hold their results under the given JSON key,
+// alongside a "meta" object with the pagination details.
+func Field[T any](key string) Extractor[T] {
+	return func(body []byte) ([]T, Meta, error) {
+		var items []T
+		var meta Meta
+		var raw map[string]json.RawMessage
+
+		err := json.Unmarshal(body, &raw)
+		if err != nil {
+			return nil, meta, err
+		}
+
+		if v, ok := raw[key]; ok {
+			err = json.Unmarshal(v, &items)
+			if err != nil {
+				return nil, meta, err
+			}
+		}
+
+		if v, ok := raw["meta"]; ok {
+			err = json.Unmarshal(v, &meta)
+			if err != nil {
+				return nil, meta, err
+			}
+		}
+
+		return items, meta, nil
+	}
+}
+
+// Yields each page of results in order. If MaxResults is set, the final page is trimmed so
+// that no more than MaxResults items are returned in total.
+func (l List[T]) Pages(ctx context.Context) iter.Seq2[Page[T], error] {
+	return func(yield func(Page[T], error) bool) {
+		params := l.Params
+		offset := 0
+		cursor := Cursor{
+			TotalRequested: l.MaxResults,
+		}
+
+		if l.Resume != nil {
+			if pos, ok := l.Resume(); ok {
+				l.debug("resuming from a saved position")
+				params = pos.Params
+				offset = pos.Offset
+			}
+		}
+
+		for !cursor.MaxResultsReturned() {
+			page, err := l.fetchPage(ctx, params)
+			if err != nil {
+				yield(Page[T]{}, err)
+				return
+			}
+
+			if offset > 0 {
+				page.Items = page.Items[min(offset, len(page.Items)):]
+				page.Offset = offset
+				offset = 0
+			}
+
+			cursor.UpdatePagination(page.Meta)
+			params = cursor.GetNextPageParams()
+
+			if !l.emit(&cursor, page, yield) {
+				return
+			}
+
+			if !cursor.ReturnMoreResults() {
+				l.debug("no more results to return")
+				return
+			}
+
+			// Once the first page has told us how many pages there are, the rest can be
+			// fetched concurrently.
+			if l.Prefetch > 1 && cursor.Meta.Pages >= cursor.Meta.NextPageNum {
+				if !l.prefetch(ctx, &cursor, yield) {
+					return
+				}
+
+				if !cursor.ReturnMoreResults() {
+					l.debug("no more results to return")
+					return
+				}
+				params = cursor.GetNextPageParams()
+			}
+		}
+	}
+}
+
+type pageResult[T any] struct {
+	page Page[T]
+	err  error
+}
+
+// Fetch every remaining page up to Meta.Pages with at most Prefetch requests in flight, and yield
+// them in page order. Pages that have been fetched but not yet consumed count against the limit,
+// so a slow consumer never causes more than Prefetch pages to be held in memory. Returns false if
+// iteration should stop.
+func (l List[T]) prefetch(ctx context.Context, cursor *Cursor, yield func(Page[T], error) bool) bool {
+	first := cursor.Meta.NextPageNum
+	last := cursor.Meta.Pages
+	if l.MaxResults > 0 && cursor.Meta.PerPage > 0 {
+		needed := (l.MaxResults - cursor.CurrentCounter() + cursor.Meta.PerPage - 1) / cursor.Meta.PerPage
+		last = min(last, first+needed-1)
+	}
+	base := cursor.GetNextPageParams()
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		cancel()
+	}()
+
+	// Each result channel is buffered so that in-flight requests never block once the
+	// consumer has stopped.
+	results := make([]chan pageResult[T], last-first+1)
+	for i := range results {
+		results[i] = make(chan pageResult[T], 1)
+	}
+	slots := make(chan struct{}, l.Prefetch)
+
+	go func() {
+		for i := range results {
+			select {
+			case slots <- struct{}{}:
+			case <-done:
+				return
+			}
+
+			params := make(map[string]any, len(base)+1)
+			for k, v := range base {
+				params[k] = v
+			}
+			params["page"] = strconv.Itoa(first + i)
+
+			go func() {
+				page, err := l.fetchPage(ctx, params)
+				results[i] <- pageResult[T]{page: page, err: err}
+			}()
+		}
+	}()
+
+	for i := range results {
+		r := <-results[i]
+		<-slots
+
+		if r.err != nil {
+			yield(Page[T]{}, r.err)
+			return false
+		}
+
+		cursor.UpdatePagination(r.page.Meta)
+
+		if !l.emit(cursor, r.page, yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (l List[T]) fetchPage(ctx context.Context, params map[string]any) (Page[T], error) {
+	body, err := l.Fetch(ctx, params)
+	if err != nil {
+		return Page[T]{}, err
+	}
+
+	items, meta, err := l.Extract(body)
+	if err != nil {
+		return Page[T]{}, err
+	}
+
+	return Page[T]{Items: items, Meta: meta, Params: params}, nil
+}
+
+// Trim the page to MaxResults, count it against the cursor, and yield it. Returns false if
+// iteration should stop.
+func (l List[T]) emit(cursor *Cursor, page Page[T], yield func(Page[T], error) bool) bool {
+	if remaining := l.MaxResults - cursor.CurrentCounter(); l.MaxResults > 0 && len(page.Items) > remaining {
+		page.Items = page.Items[:remaining]
+	}
+	cursor.AddToCounter(len(page.Items))
+
+	if !yield(page, nil) {
+		return false
+	}
+
+	if cursor.MaxResultsReturned() {
+		l.debug("hit the limit of results to return")
+		return false
+	}
+
+	return true
+}
+
+// Yields each individual result in order, fetching the next page only once every result from
+// the current page has been consumed.
+func (l List[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for page, err := range l.Pages(ctx) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			if l.PageStart != nil {
+				l.PageStart(len(page.Items))
+			}
+
+			for i, v := range page.Items {
+				if l.Track != nil {
+					l.Track(Position{Params: page.Params, Offset: page.Offset + i + 1})
+				}
+				if !yield(v, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (l List[T]) debug(msg string) {
+	if l.Debug != nil {
+		l.Debug(msg)
+	}
+}
@@ -0,0 +1,249 @@
+package paginate_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+// Returns a Fetcher that serves `pages` pages of `perPage` sequential integers, and a pointer to
+// the number of requests made so far.
+func testFetcher(pages, perPage int) (paginate.Fetcher, *atomic.Int32) {
+	var calls atomic.Int32
+	return func(ctx context.Context, params map[string]any) ([]byte, error) {
+		calls.Add(1)
+		page := 1
+		if p, ok := params["page"]; ok {
+			fmt.Sscan(p.(string), &page)
+		}
+
+		items := []int{}
+		for i := range perPage {
+			items = append(items, (page-1)*perPage+i+1)
+		}
+
+		next, nextNum := "null", 0
+		if page > pages {
+			return nil, fmt.Errorf("page %d out of range", page)
+		}
+		if page < pages {
+			next = fmt.Sprintf(`"https://example.com/?per_page=%d&page=%d"`, perPage, page+1)
+			nextNum = page + 1
+		}
+
+		body := fmt.Sprintf(`{"numbers":%s,"meta":{"next_page":%s,"next_page_number":%d,"per_page":%d,"pages":%d}}`,
+			intsToJson(items), next, nextNum, perPage, pages)
+		return []byte(body), nil
+	}, &calls
+}
+
+func collect(seq iter.Seq2[int, error]) ([]int, error) {
+	var results []int
+
+	for v, err := range seq {
+		if err != nil {
+			return results, err
+		}
+		results = append(results, v)
+	}
+
+	return results, nil
+}
+
+func intsToJson(items []int) string {
+	s := "["
+	for i, v := range items {
+		if i > 0 {
+			s += ","
+		}
+		s += fmt.Sprint(v)
+	}
+	return s + "]"
+}
+
+func TestListAll(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, calls := testFetcher(3, 2)
+	l := paginate.List[int]{
+		Fetch:   fetch,
+		Extract: paginate.Field[int]("numbers"),
+	}
+
+	res, err := collect(l.All(context.Background()))
+
+	assert.Nil(err, "collects all pages without an error")
+	assert.Equal([]int{1, 2, 3, 4, 5, 6}, res, "returns every result in order")
+	assert.Equal(int32(3), calls.Load(), "fetches every page exactly once")
+}
+
+func TestListMaxResults(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, calls := testFetcher(3, 2)
+	l := paginate.List[int]{
+		Fetch:      fetch,
+		Extract:    paginate.Field[int]("numbers"),
+		MaxResults: 3,
+	}
+
+	pages := [][]int{}
+	for p, err := range l.Pages(context.Background()) {
+		assert.Nil(err, "fetches each page without an error")
+		pages = append(pages, p.Items)
+	}
+
+	assert.Equal([][]int{{1, 2}, {3}}, pages, "trims the final page to the maximum number of results")
+	assert.Equal(int32(2), calls.Load(), "stops fetching pages once the maximum number of results is reached")
+}
+
+func TestListStopsEarly(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, calls := testFetcher(3, 2)
+	l := paginate.List[int]{
+		Fetch:   fetch,
+		Extract: paginate.Field[int]("numbers"),
+	}
+
+	for v, err := range l.All(context.Background()) {
+		assert.Nil(err, "yields a result without an error")
+		if v == 2 {
+			break
+		}
+	}
+
+	assert.Equal(int32(1), calls.Load(), "does not fetch the next page once the consumer stops")
+}
+
+func TestListFetchError(t *testing.T) {
+	assert := assert.New(t)
+
+	fetchErr := errors.New("boom")
+	l := paginate.List[int]{
+		Fetch: func(ctx context.Context, params map[string]any) ([]byte, error) {
+			return nil, fetchErr
+		},
+		Extract: paginate.Field[int]("numbers"),
+	}
+
+	res, err := collect(l.All(context.Background()))
+
+	assert.ErrorIs(err, fetchErr, "returns the error from the fetcher")
+	assert.Empty(res, "returns no results")
+}
+
+func TestListPrefetch(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, calls := testFetcher(10, 2)
+	var inFlight, maxInFlight atomic.Int32
+	l := paginate.List[int]{
+		Fetch: func(ctx context.Context, params map[string]any) ([]byte, error) {
+			n := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				m := maxInFlight.Load()
+				if n <= m || maxInFlight.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return fetch(ctx, params)
+		},
+		Extract:  paginate.Field[int]("numbers"),
+		Prefetch: 3,
+	}
+
+	res, err := collect(l.All(context.Background()))
+
+	expected := []int{}
+	for i := range 20 {
+		expected = append(expected, i+1)
+	}
+
+	assert.Nil(err, "collects all pages without an error")
+	assert.Equal(expected, res, "returns every result in page order")
+	assert.Equal(int32(10), calls.Load(), "fetches every page exactly once")
+	assert.LessOrEqual(maxInFlight.Load(), int32(3), "never has more than Prefetch requests in flight")
+	assert.Greater(maxInFlight.Load(), int32(1), "fetches pages concurrently")
+}
+
+func TestListPrefetchMaxResults(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, calls := testFetcher(10, 2)
+	l := paginate.List[int]{
+		Fetch:      fetch,
+		Extract:    paginate.Field[int]("numbers"),
+		MaxResults: 5,
+		Prefetch:   4,
+	}
+
+	res, err := collect(l.All(context.Background()))
+
+	assert.Nil(err, "collects all pages without an error")
+	assert.Equal([]int{1, 2, 3, 4, 5}, res, "returns only the maximum number of results")
+	assert.Equal(int32(3), calls.Load(), "only fetches the pages needed to reach the maximum number of results")
+}
+
+func TestListPrefetchError(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, _ := testFetcher(10, 1)
+	fetchErr := errors.New("boom")
+	l := paginate.List[int]{
+		Fetch: func(ctx context.Context, params map[string]any) ([]byte, error) {
+			if params["page"] == "4" {
+				return nil, fetchErr
+			}
+			return fetch(ctx, params)
+		},
+		Extract:  paginate.Field[int]("numbers"),
+		Prefetch: 3,
+	}
+
+	res, err := collect(l.All(context.Background()))
+
+	assert.ErrorIs(err, fetchErr, "returns the first error from the fetcher")
+	assert.Equal([]int{1, 2, 3}, res, "returns the results from every page before the failed one")
+}
+
+func TestListResume(t *testing.T) {
+	assert := assert.New(t)
+
+	fetch, _ := testFetcher(3, 2)
+	var pos paginate.Position
+	l := paginate.List[int]{
+		Fetch:   fetch,
+		Extract: paginate.Field[int]("numbers"),
+		Track: func(p paginate.Position) {
+			pos = p
+		},
+	}
+
+	for v, err := range l.All(context.Background()) {
+		assert.Nil(err, "yields a result without an error")
+		if v == 3 {
+			break
+		}
+	}
+
+	assert.Equal(1, pos.Offset, "tracks the position just past the last result yielded")
+
+	l.Resume = func() (paginate.Position, bool) {
+		return pos, true
+	}
+
+	res, err := collect(l.All(context.Background()))
+
+	assert.Nil(err, "collects the remaining pages without an error")
+	assert.Equal([]int{4, 5, 6}, res, "resumes from the tracked position")
+}
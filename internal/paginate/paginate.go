@@ -26,6 +26,10 @@ func (c *Cursor) IncrementCounter() {
 	c.totalReturned++
 }
 
+func (c *Cursor) AddToCounter(n int) {
+	c.totalReturned += n
+}
+
 func (c *Cursor) CurrentCounter() int {
 	return c.totalReturned
 }
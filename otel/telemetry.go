@@ -0,0 +1,189 @@
+// Package otel reports the traces and metrics of a Tines API client to OpenTelemetry. It is a
+// separate module so that the core SDK does not depend on OpenTelemetry.
+//
+// Example Usage:
+//
+//	tel, err := otel.NewTelemetry()
+//	if err != nil {
+//		...
+//	}
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetTelemetry(tel),
+//	)
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/tines/go-sdk/tines"
+	gootel "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/tines/go-sdk/otel"
+
+// Metric names recorded by Telemetry.
+const (
+	MetricRequestDuration = "tines.client.request.duration"
+	MetricRequestErrors   = "tines.client.request.errors"
+	MetricPages           = "tines.client.pages"
+)
+
+// Attribute keys set on metrics, in addition to the span attributes set by the SDK.
+const (
+	AttrOperation = "tines.operation"
+	AttrErrorType = "error.type"
+)
+
+// A tines.Telemetry implementation that starts an OpenTelemetry span for every API call, and
+// records request latency, errors by tines.ErrorType, and pages fetched by List functions.
+type Telemetry struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	errors   metric.Int64Counter
+	pages    metric.Int64Counter
+}
+
+// Create a new Telemetry. Unless other providers are set, the global OpenTelemetry tracer and
+// meter providers are used.
+func NewTelemetry(opts ...func(*Telemetry)) (*Telemetry, error) {
+	t := &Telemetry{
+		tracerProvider: gootel.GetTracerProvider(),
+		meterProvider:  gootel.GetMeterProvider(),
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	t.tracer = t.tracerProvider.Tracer(instrumentationName)
+	meter := t.meterProvider.Meter(instrumentationName)
+
+	var err error
+
+	t.duration, err = meter.Float64Histogram(MetricRequestDuration,
+		metric.WithDescription("The time taken by each Tines API call, including retries and rate limiting."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t.errors, err = meter.Int64Counter(MetricRequestErrors,
+		metric.WithDescription("The number of Tines API calls that returned an error."),
+		metric.WithUnit("{error}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t.pages, err = meter.Int64Counter(MetricPages,
+		metric.WithDescription("The number of pages of results fetched by List functions."),
+		metric.WithUnit("{page}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Use a specific TracerProvider instead of the global one.
+func SetTracerProvider(tp trace.TracerProvider) func(*Telemetry) {
+	return func(t *Telemetry) {
+		t.tracerProvider = tp
+	}
+}
+
+// Use a specific MeterProvider instead of the global one.
+func SetMeterProvider(mp metric.MeterProvider) func(*Telemetry) {
+	return func(t *Telemetry) {
+		t.meterProvider = mp
+	}
+}
+
+// Implements tines.Telemetry.
+func (t *Telemetry) StartSpan(ctx context.Context, name string) (context.Context, tines.Span) {
+	ctx, span := t.tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	return ctx, otelSpan{span: span}
+}
+
+// Implements tines.Telemetry.
+func (t *Telemetry) RecordRequest(ctx context.Context, r tines.RequestRecord) {
+	attrs := []attribute.KeyValue{
+		attribute.String(AttrOperation, r.Operation),
+		attribute.String(tines.AttrHttpMethod, r.Method),
+	}
+	if r.StatusCode != 0 {
+		attrs = append(attrs, attribute.Int(tines.AttrHttpStatusCode, r.StatusCode))
+	}
+	if r.ErrorType != "" {
+		attrs = append(attrs, attribute.String(AttrErrorType, string(r.ErrorType)))
+	}
+
+	set := metric.WithAttributes(attrs...)
+	t.duration.Record(ctx, r.Latency.Seconds(), set)
+	if r.ErrorType != "" {
+		t.errors.Add(ctx, 1, set)
+	}
+}
+
+// Implements tines.Telemetry.
+func (t *Telemetry) RecordPage(ctx context.Context, operation string, page int) {
+	t.pages.Add(ctx, 1, metric.WithAttributes(attribute.String(AttrOperation, operation)))
+}
+
+type otelSpan struct {
+	span trace.Span
+}
+
+func (s otelSpan) SetAttributes(attrs ...tines.Attribute) {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		kvs = append(kvs, keyValue(a))
+	}
+	s.span.SetAttributes(kvs...)
+}
+
+func (s otelSpan) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+
+	var tErr tines.Error
+	if errors.As(err, &tErr) && tErr.Type != "" {
+		s.span.SetAttributes(attribute.String(AttrErrorType, string(tErr.Type)))
+	}
+}
+
+func (s otelSpan) End() {
+	s.span.End()
+}
+
+// Convert a tines.Attribute to an OpenTelemetry attribute of the matching type.
+func keyValue(a tines.Attribute) attribute.KeyValue {
+	switch v := a.Value.(type) {
+	case string:
+		return attribute.String(a.Key, v)
+	case int:
+		return attribute.Int(a.Key, v)
+	case int64:
+		return attribute.Int64(a.Key, v)
+	case float64:
+		return attribute.Float64(a.Key, v)
+	case bool:
+		return attribute.Bool(a.Key, v)
+	default:
+		return attribute.String(a.Key, fmt.Sprint(v))
+	}
+}
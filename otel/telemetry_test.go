@@ -0,0 +1,139 @@
+package otel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/otel"
+	"github.com/tines/go-sdk/tines"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Returns a Tines client that reports to an in-memory span recorder and metric reader.
+func createTestClient(assert *assert.Assertions, url string) (*tines.Client, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	spans := tracetest.NewSpanRecorder()
+	metrics := sdkmetric.NewManualReader()
+
+	tel, err := otel.NewTelemetry(
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+		otel.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(metrics))),
+	)
+	assert.Nil(err, "the telemetry adapter should instantiate successfully")
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(url),
+		tines.SetTelemetry(tel),
+	)
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+
+	return cli, spans, metrics
+}
+
+func attrs(kvs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := map[attribute.Key]attribute.Value{}
+	for _, kv := range kvs {
+		m[kv.Key] = kv.Value
+	}
+	return m
+}
+
+// Returns the named metric collected by the reader.
+func collectMetric(assert *assert.Assertions, reader *sdkmetric.ManualReader, name string) (metricdata.Metrics, bool) {
+	var rm metricdata.ResourceMetrics
+	assert.Nil(reader.Collect(context.Background(), &rm), "metrics should be collected successfully")
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m, true
+			}
+		}
+	}
+	return metricdata.Metrics{}, false
+}
+
+func TestTelemetryList(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"teams": [{"id": 2}], "meta": {"next_page": null, "pages": 2}}`)) //nolint:errcheck
+			return
+		}
+		w.Write([]byte(`{"teams": [{"id": 1}], "meta": {"next_page": "https://example.tines.com/api/v1/teams?page=2", "next_page_number": 2, "pages": 2}}`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, spans, metrics := createTestClient(assert, ts.URL)
+
+	for _, err := range cli.ListTeams(context.Background(), tines.NewListFilter()) {
+		assert.Nil(err)
+	}
+
+	ended := spans.Ended()
+	if assert.Len(ended, 2, "every API call should get a span") {
+		for i, s := range ended {
+			a := attrs(s.Attributes())
+			assert.Equal("tines.ListTeams", s.Name(), "the span should be named after the operation")
+			assert.Equal(trace.SpanKindClient, s.SpanKind())
+			assert.Equal(codes.Unset, s.Status().Code, "a successful call should not set an error status")
+			assert.Equal(http.MethodGet, a[tines.AttrHttpMethod].AsString(), "string attributes should keep their type")
+			assert.Equal(int64(http.StatusOK), a[tines.AttrHttpStatusCode].AsInt64(), "int attributes should keep their type")
+			assert.Equal(int64(i+1), a[tines.AttrPage].AsInt64(), "the span should record the page number")
+		}
+	}
+
+	pages, ok := collectMetric(assert, metrics, otel.MetricPages)
+	if assert.True(ok, "pages fetched should be recorded") {
+		sum := pages.Data.(metricdata.Sum[int64])
+		assert.Equal(int64(2), sum.DataPoints[0].Value, "every page fetched should be counted")
+	}
+
+	duration, ok := collectMetric(assert, metrics, otel.MetricRequestDuration)
+	if assert.True(ok, "request latency should be recorded") {
+		hist := duration.Data.(metricdata.Histogram[float64])
+		assert.Equal(uint64(2), hist.DataPoints[0].Count, "every API call should be timed")
+	}
+}
+
+func TestTelemetryError(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors": [{"message": "not found"}]}`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, spans, metrics := createTestClient(assert, ts.URL)
+
+	_, err := cli.GetTeam(context.Background(), 1)
+	assert.NotNil(err)
+
+	ended := spans.Ended()
+	if assert.Len(ended, 1) {
+		s := ended[0]
+		assert.Equal("tines.GetTeam", s.Name())
+		assert.Equal(codes.Error, s.Status().Code, "a failed call should set an error status")
+		assert.Equal(string(tines.ErrorTypeNotFound), attrs(s.Attributes())[otel.AttrErrorType].AsString(), "the error type should be recorded on the span")
+		assert.Len(s.Events(), 1, "the error should be recorded as a span event")
+	}
+
+	errs, ok := collectMetric(assert, metrics, otel.MetricRequestErrors)
+	if assert.True(ok, "errors should be counted") {
+		sum := errs.Data.(metricdata.Sum[int64])
+		if assert.Len(sum.DataPoints, 1) {
+			errType, _ := sum.DataPoints[0].Attributes.Value(otel.AttrErrorType)
+			assert.Equal(string(tines.ErrorTypeNotFound), errType.AsString(), "errors should be counted by error type")
+			assert.Equal(int64(1), sum.DataPoints[0].Value)
+		}
+	}
+}
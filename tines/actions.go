@@ -1,3 +1,253 @@
 package tines
 
-// TODO: This endpoint is not fully implemented in the SDK yet.
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+type ActionType string
+
+const (
+	ActionTypeEmail          ActionType = "Agents::EmailAgent"
+	ActionTypeEventTransform ActionType = "Agents::EventTransformationAgent"
+	ActionTypeGroup          ActionType = "Agents::GroupAgent"
+	ActionTypeHttpRequest    ActionType = "Agents::HTTPRequestAgent"
+	ActionTypeImap           ActionType = "Agents::IMAPAgent"
+	ActionTypeLlm            ActionType = "Agents::LLMAgent"
+	ActionTypeSendToStory    ActionType = "Agents::SendToStoryAgent"
+	ActionTypeTrigger        ActionType = "Agents::TriggerAgent"
+	ActionTypeWebhook        ActionType = "Agents::WebhookAgent"
+)
+
+type Action struct {
+	ID int `json:"id,omitempty"`
+	// Required field to create a new Action.
+	Type ActionType `json:"type,omitempty"`
+	// Required field to create a new Action.
+	Name string `json:"name,omitempty"`
+	// Required field to create a new Action. The valid options depend on the ActionType.
+	Options map[string]any `json:"options,omitempty"`
+	// Required field to create a new Action, unless the Action is created inside a group.
+	StoryID                int             `json:"story_id,omitempty"`
+	GroupID                int             `json:"group_id,omitempty"`
+	TeamID                 int             `json:"team_id,omitempty"`
+	UserID                 int             `json:"user_id,omitempty"`
+	Description            string          `json:"description,omitempty"`
+	Disabled               bool            `json:"disabled,omitempty"`
+	Guid                   string          `json:"guid,omitempty"`
+	Slug                   string          `json:"slug,omitempty"`
+	Schedule               []any           `json:"schedule,omitempty"`
+	Position               *ActionPosition `json:"position,omitempty"`
+	Sources                []int           `json:"sources,omitempty"`
+	Receivers              []int           `json:"receivers,omitempty"`
+	SourceIDs              []int           `json:"source_ids,omitempty"`
+	ReceiverIDs            []int           `json:"receiver_ids,omitempty"`
+	KeepEventsFor          int             `json:"keep_events_for,omitempty"`
+	LogsCount              int             `json:"logs_count,omitempty"`
+	MonitorAllEvents       bool            `json:"monitor_all_events,omitempty"`
+	MonitorFailures        bool            `json:"monitor_failures,omitempty"`
+	MonitorNoEventsEmitted int             `json:"monitor_no_events_emitted,omitempty"`
+	CreatedAt              Time            `json:"created_at,omitzero"`
+	UpdatedAt              Time            `json:"updated_at,omitzero"`
+	LastEventAt            Time            `json:"last_event_at,omitzero"`
+	LastErrorLogAt         Time            `json:"last_error_log_at,omitzero"`
+}
+
+// The coordinates of an Action on the storyboard.
+type ActionPosition struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+type ActionLog struct {
+	ID             int      `json:"id,omitempty"`
+	ActionID       int      `json:"agent_id,omitempty"`
+	Level          LogLevel `json:"level,omitempty"`
+	Message        string   `json:"message,omitempty"`
+	InboundEventID int      `json:"inbound_event_id,omitempty"`
+	CreatedAt      Time     `json:"created_at,omitzero"`
+}
+
+type ActionList struct {
+	Agents []Action      `json:"agents,omitempty"`
+	Meta   paginate.Meta `json:"meta,omitempty"`
+}
+
+type ActionLogList struct {
+	ActionLogs []ActionLog   `json:"action_logs,omitempty"`
+	Meta       paginate.Meta `json:"meta,omitempty"`
+}
+
+// Create a new Action on a storyboard. Type, Name, Options, and StoryID (or GroupID)
+// are required parameters.
+func (c *Client) CreateAction(ctx context.Context, a *Action) (*Action, error) {
+	ctx = withOperation(ctx, "CreateAction")
+	resource := "/api/v1/actions"
+	errs := Error{Type: ErrorTypeRequest}
+	newAction := Action{}
+
+	if a.Type == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Action Type must not be empty",
+		})
+	}
+
+	if a.Name == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Action Name must not be empty",
+		})
+	}
+
+	if a.StoryID == 0 && a.GroupID == 0 {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Action Story ID or Group ID must not be empty",
+		})
+	}
+
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	req, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &newAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newAction, nil
+}
+
+// Get current state for an Action.
+func (c *Client) GetAction(ctx context.Context, id int) (*Action, error) {
+	ctx = withOperation(ctx, "GetAction")
+	resource := fmt.Sprintf("/api/v1/actions/%d", id)
+	action := Action{}
+
+	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &action)
+	if err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// Update an Action. Only the non-empty fields of the provided Action are sent to the API,
+// so a partial Action (for example, one with only the Options field set) can be used to
+// change a single attribute.
+func (c *Client) UpdateAction(ctx context.Context, id int, values *Action) (*Action, error) {
+	ctx = withOperation(ctx, "UpdateAction")
+	resource := fmt.Sprintf("/api/v1/actions/%d", id)
+	updatedAction := Action{}
+
+	req, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &updatedAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedAction, nil
+}
+
+// Yields an iterator that returns individual Actions, optionally filtered by Story ID and/or
+// Team ID. If no other filters are specified, ListActions() will recurse through all pages of
+// results until no more are available. If `filters.WithMaxResults()` is set, this function
+// will yield either the actual set of results or the specified maximum number of results,
+// whichever is less.
+//
+// Example Usage:
+//
+//	for a, err := range ListActions(ctx, NewListFilter(WithStoryId(1))) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(a.Name)
+//	}
+func (c *Client) ListActions(ctx context.Context, f ListFilter) iter.Seq2[Action, error] {
+	ctx = withOperation(ctx, "ListActions")
+	resource := "/api/v1/actions"
+
+	return newList[Action](c, resource, "agents", f).All(ctx)
+}
+
+// Delete an Action.
+func (c *Client) DeleteAction(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteAction")
+	resource := fmt.Sprintf("/api/v1/actions/%d", id)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
+
+// Yields an iterator that returns the logs written by an Action, optionally filtered by
+// severity with `filters.WithLogLevel()`. If no other filters are specified, ListActionLogs()
+// will recurse through all pages of results until no more are available. If
+// `filters.WithMaxResults()` is set, this function will yield either the actual set of
+// results or the specified maximum number of results, whichever is less.
+//
+// Example Usage:
+//
+//	for l, err := range ListActionLogs(ctx, 1, NewListFilter(WithLogLevel(LogLevelError))) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(l.Message)
+//	}
+func (c *Client) ListActionLogs(ctx context.Context, actionID int, f ListFilter) iter.Seq2[ActionLog, error] {
+	ctx = withOperation(ctx, "ListActionLogs")
+	resource := fmt.Sprintf("/api/v1/actions/%d/logs", actionID)
+
+	return newList[ActionLog](c, resource, "action_logs", f).All(ctx)
+}
+
+// Clear the memory of an Action, for example the deduplication history of a Deduplicate Action
+// or the buffered events of an Implode Action.
+func (c *Client) ClearActionMemory(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "ClearActionMemory")
+	resource := fmt.Sprintf("/api/v1/actions/%d/clear_memory", id)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
+
+// Delete all Events emitted by an Action.
+func (c *Client) ClearActionEvents(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "ClearActionEvents")
+	resource := fmt.Sprintf("/api/v1/actions/%d/remove_events", id)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
@@ -0,0 +1,398 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API request.
+	testCreateActionReq = `
+{
+    "type": "Agents::EventTransformationAgent",
+    "name": "Test Action",
+    "options": {
+        "mode": "message_only",
+        "payload": {
+            "message": "hello"
+        }
+    },
+    "story_id": 1,
+    "position": {
+        "x": 360,
+        "y": 240
+    }
+}`
+	// Hand-written example API response.
+	testActionResp = `
+{
+    "id": 1,
+    "type": "Agents::EventTransformationAgent",
+    "user_id": 1,
+    "options": {
+        "mode": "message_only",
+        "payload": {
+            "message": "hello"
+        }
+    },
+    "name": "Test Action",
+    "schedule": null,
+    "disabled": false,
+    "guid": "6bba07417c1732ae4b2e7b642dcc9cea",
+    "slug": "test_action",
+    "description": null,
+    "story_id": 1,
+    "group_id": null,
+    "team_id": 1,
+    "sources": [],
+    "receivers": [],
+    "keep_events_for": 604800,
+    "logs_count": 0,
+    "monitor_all_events": false,
+    "monitor_failures": false,
+    "monitor_no_events_emitted": null,
+    "position": {
+        "x": 360,
+        "y": 240
+    },
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:00:00Z",
+    "last_event_at": null,
+    "last_error_log_at": null
+}`
+	// Hand-written example API response.
+	testUpdateActionResp = `
+{
+    "id": 1,
+    "type": "Agents::EventTransformationAgent",
+    "user_id": 1,
+    "options": {
+        "mode": "message_only",
+        "payload": {
+            "message": "updated"
+        }
+    },
+    "name": "Test Action",
+    "disabled": false,
+    "guid": "6bba07417c1732ae4b2e7b642dcc9cea",
+    "story_id": 1,
+    "team_id": 1,
+    "sources": [],
+    "receivers": [],
+    "position": {
+        "x": 360,
+        "y": 240
+    },
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:05:00Z"
+}`
+	// Hand-written example API response.
+	testListActionsResp = `
+{
+    "agents": [
+        {
+            "id": 1,
+            "type": "Agents::WebhookAgent",
+            "user_id": 1,
+            "options": {
+                "path": "2a5b9cd5b43d06329fd72f70c7cbeede",
+                "secret": "cf881382af21ef97840c36aa9391f6cc",
+                "verbs": "get,post"
+            },
+            "name": "Webhook Action",
+            "disabled": false,
+            "guid": "7977a7af73df9e18234ae8acb814d4fa",
+            "story_id": 1,
+            "team_id": 1,
+            "sources": [],
+            "receivers": [2],
+            "position": {
+                "x": 360,
+                "y": 135
+            },
+            "created_at": "2025-06-02T00:00:00Z",
+            "updated_at": "2025-06-02T00:00:00Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/actions?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+	// Hand-written example API response.
+	testListActionLogsResp = `
+{
+    "action_logs": [
+        {
+            "id": 1,
+            "agent_id": 1,
+            "level": 4,
+            "message": "Request failed with status 500",
+            "inbound_event_id": 1,
+            "created_at": "2025-06-02T03:00:00Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/actions/1/logs?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+)
+
+func TestCreateAction(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/actions", http.StatusCreated, []byte(testCreateActionReq), []byte(testActionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	draft := tines.Action{
+		Type: tines.ActionTypeEventTransform,
+		Name: "Test Action",
+		Options: map[string]any{
+			"mode":    "message_only",
+			"payload": map[string]any{"message": "hello"},
+		},
+		StoryID:  1,
+		Position: &tines.ActionPosition{X: 360, Y: 240},
+	}
+
+	action, err := cli.CreateAction(ctx, &draft)
+
+	assert.Nil(err, "the Tines client should create an action successfully")
+	assert.Equal(1, action.ID, "the created action ID should be parsed")
+	assert.Equal(tines.ActionTypeEventTransform, action.Type, "the created action type should match the request")
+}
+
+func TestCreateActionMissingFields(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusCreated, nil, []byte(testActionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.CreateAction(ctx, &tines.Action{})
+
+	assert.Error(err, "the Tines client should refuse to create an action without required fields")
+}
+
+func TestGetAction(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/actions/1", http.StatusOK, nil, []byte(testActionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	action, err := cli.GetAction(ctx, 1)
+
+	assert.Nil(err, "the Tines client should retrieve an action successfully")
+	assert.Equal("Test Action", action.Name, "the Tines client should retrieve and parse the action successfully")
+	assert.Equal(240, action.Position.Y, "the action position should be parsed")
+}
+
+func TestUpdateAction(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/actions/1", http.StatusOK, []byte(`{"options": {"payload": {"message": "updated"}}}`), []byte(testUpdateActionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	update := tines.Action{
+		Options: map[string]any{"payload": map[string]any{"message": "updated"}},
+	}
+
+	action, err := cli.UpdateAction(ctx, 1, &update)
+
+	assert.Nil(err, "the Tines client should update an action successfully")
+	assert.Equal(map[string]any{"message": "updated"}, action.Options["payload"], "the action options should be updated")
+}
+
+func TestListActions(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodGet, r.Method, "client should use the expected HTTP method")
+		assert.Equal("/api/v1/actions", r.URL.Path, "client should call the expected endpoint")
+		q := r.URL.Query()
+		assert.Equal("1", q.Get("story_id"), "the story filter should be sent")
+		assert.Equal("1", q.Get("team_id"), "the team filter should be sent")
+		w.Write([]byte(testListActionsResp)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	lf := tines.NewListFilter(
+		tines.WithStoryId(1),
+		tines.WithTeamId(1),
+	)
+
+	for a, err := range cli.ListActions(ctx, lf) {
+		assert.Nil(err, "the list of actions should be iterable")
+		assert.Equal(tines.ActionTypeWebhook, a.Type, "the action type should be retrieved successfully")
+		assert.Equal([]int{2}, a.Receivers, "the action receivers should be retrieved successfully")
+	}
+}
+
+func TestDeleteAction(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/actions/1", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.DeleteAction(ctx, 1)
+
+	assert.Nil(err, "the Tines client should delete the action successfully")
+}
+
+func TestListActionLogs(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodGet, r.Method, "client should use the expected HTTP method")
+		assert.Equal("/api/v1/actions/1/logs", r.URL.Path, "the logs for the requested action should be fetched")
+		assert.Equal("4", r.URL.Query().Get("level"), "the log level filter should be sent")
+		w.Write([]byte(testListActionLogsResp)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	lf := tines.NewListFilter(
+		tines.WithLogLevel(tines.LogLevelError),
+	)
+
+	for l, err := range cli.ListActionLogs(ctx, 1, lf) {
+		assert.Nil(err, "the list of action logs should be iterable")
+		assert.Equal(tines.LogLevelError, l.Level, "the log level should be retrieved successfully")
+		assert.Equal("Request failed with status 500", l.Message, "the log message should be retrieved successfully")
+	}
+}
+
+func TestClearActionMemory(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/actions/1/clear_memory", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.ClearActionMemory(ctx, 1)
+
+	assert.Nil(err, "the Tines client should clear the action memory successfully")
+}
+
+func TestClearActionEvents(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/actions/1/remove_events", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.ClearActionEvents(ctx, 1)
+
+	assert.Nil(err, "the Tines client should clear the action events successfully")
+}
@@ -3,26 +3,68 @@ package tines
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"iter"
-	"net/http"
+	"slices"
+	"time"
 
 	"github.com/tines/go-sdk/internal/paginate"
 )
 
 type AuditLog struct {
-	CreatedAt     string      `json:"created_at"`
-	OperationName string      `json:"operation_name"`
-	Id            int         `json:"id"`
-	Inputs        interface{} `json:"inputs"`
-	Outputs       interface{} `json:"outputs"`
-	RequestIP     string      `json:"request_ip"`
-	RequestUA     string      `json:"request_user_agent"`
-	StoryID       int         `json:"story_id"`
-	TenantID      int         `json:"tenant_id"`
-	UpdatedAt     string      `json:"updated_at"`
-	UserEmail     string      `json:"user_email"`
-	UserID        int         `json:"user_id"`
-	UserName      string      `json:"user_name"`
+	CreatedAt     Time            `json:"created_at"`
+	OperationName string          `json:"operation_name"`
+	Id            int             `json:"id"`
+	Inputs        json.RawMessage `json:"inputs"`
+	Outputs       json.RawMessage `json:"outputs"`
+	RequestIP     string          `json:"request_ip"`
+	RequestUA     string          `json:"request_user_agent"`
+	StoryID       int             `json:"story_id"`
+	TenantID      int             `json:"tenant_id"`
+	UpdatedAt     Time            `json:"updated_at"`
+	UserEmail     string          `json:"user_email"`
+	UserID        int             `json:"user_id"`
+	UserName      string          `json:"user_name"`
+}
+
+// Operation names for the audit log entries that have typed decoding helpers. The full list of
+// logged operations is available at https://www.tines.com/api/audit-logs/.
+const (
+	AuditOpStoryCreated      = "StoryCreation"
+	AuditOpCredentialUpdated = "UserCredentialUpdate"
+	AuditOpUserSignedIn      = "Login"
+)
+
+// The details of a StoryCreation audit log entry.
+type AuditStoryCreated struct {
+	StoryID     int    `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	TeamID      int    `json:"teamId"`
+	FolderID    int    `json:"folderId"`
+	CreatedBy   int    `json:"-"`
+	CreatedAt   Time   `json:"-"`
+}
+
+// The details of a UserCredentialUpdate audit log entry. Credential values are never included
+// in the audit log.
+type AuditCredentialUpdated struct {
+	CredentialID int            `json:"id"`
+	Name         string         `json:"name"`
+	Mode         CredentialType `json:"mode"`
+	Description  string         `json:"description"`
+	UpdatedBy    int            `json:"-"`
+	UpdatedAt    Time           `json:"-"`
+}
+
+// The details of a Login audit log entry.
+type AuditUserSignedIn struct {
+	UserID     int
+	UserEmail  string
+	UserName   string
+	RequestIP  string
+	RequestUA  string
+	SignedInAt Time
 }
 
 type AuditLogList struct {
@@ -31,53 +73,215 @@ type AuditLogList struct {
 }
 
 func (c *Client) ListAuditLogs(ctx context.Context, f ListFilter) iter.Seq2[AuditLog, error] {
-	var auditlogList, resultList AuditLogList
+	ctx = withOperation(ctx, "ListAuditLogs")
 	resource := "/api/v1/audit_logs"
-	params := f.ToParamMap()
-	page := paginate.Cursor{
-		TotalRequested: f.MaxResults(),
+
+	return newList[AuditLog](c, resource, "audit_logs", f).All(ctx)
+}
+
+// Yields an iterator that follows the audit log, returning new entries as they appear until the
+// context is cancelled. Every `pollInterval` (default 1 minute), the audit log is listed again
+// with `After` set to the newest CreatedAt seen so far, and entries that have already been
+// returned are skipped. Each poll returns every new entry in chronological order, so
+// WithMaxResults() is ignored and any WithResultsBefore() filter is cleared.
+//
+// Following starts from the current time unless WithResultsAfter() sets an earlier start point.
+// Each poll is sorted in memory before it is yielded, so an early start point makes the first
+// poll download every entry since then before returning any of them.
+//
+// If a poll fails, the error is yielded and the next poll retries from the same point. Stop
+// iterating to stop following.
+//
+// Example Usage:
+//
+//	lf := NewListFilter(WithResultsAfter("2025-01-01"))
+//	for l, err := range FollowAuditLogs(ctx, lf, 30*time.Second) {
+//		if err != nil {
+//			...
+//			continue
+//		}
+//		fmt.Println(l.OperationName)
+//	}
+func (c *Client) FollowAuditLogs(ctx context.Context, f ListFilter, pollInterval time.Duration) iter.Seq2[AuditLog, error] {
+	ctx = withOperation(ctx, "FollowAuditLogs")
+	if pollInterval <= 0 {
+		pollInterval = time.Minute
 	}
 
 	return func(yield func(AuditLog, error) bool) {
+		lf := f
+		lf.maxResults = 0
+		lf.cursor = nil
+		lf.pageStart = nil
+		lf.Before = ""
+		if lf.After == "" {
+			lf.After = time.Now().UTC().Format(time.RFC3339)
+		}
 
-		for !page.MaxResultsReturned() {
-			res, err := c.doRequest(ctx, http.MethodGet, resource, params, nil)
-			if err != nil {
-				yield(AuditLog{}, err)
+		var newest time.Time
+		seen := make(map[int]time.Time)
+
+		for {
+			logs, err := Collect(c.ListAuditLogs(ctx, lf))
+			if ctx.Err() != nil {
 				return
 			}
 
-			err = json.Unmarshal(res, &resultList)
 			if err != nil {
-				yield(AuditLog{}, err)
-				return
-			}
+				if !yield(AuditLog{}, err) {
+					return
+				}
+			} else {
+				slices.SortStableFunc(logs, func(a, b AuditLog) int {
+					return a.CreatedAt.Compare(b.CreatedAt.Time)
+				})
 
-			page.UpdatePagination(resultList.Meta)
-			params = page.GetNextPageParams()
+				for _, l := range logs {
+					if _, ok := seen[l.Id]; ok {
+						continue
+					}
 
-			for _, v := range resultList.AuditLogs {
-				auditlogList.AuditLogs = append(auditlogList.AuditLogs, v)
-				page.IncrementCounter()
-				if page.MaxResultsReturned() {
-					c.logger.Debug("hit the limit of results to return")
-					break
+					t := l.CreatedAt.Time
+					seen[l.Id] = t
+					if t.After(newest) {
+						newest = t
+					}
+
+					if !yield(l, nil) {
+						return
+					}
+				}
+
+				// Timestamps in the After filter only have second precision, so keep every
+				// entry from the newest second around to de-duplicate the next poll.
+				after := newest.Truncate(time.Second)
+				for id, t := range seen {
+					if t.Before(after) {
+						delete(seen, id)
+					}
+				}
+				if !newest.IsZero() {
+					lf.After = after.UTC().Format(time.RFC3339)
 				}
 			}
 
-			// Clear the temporary result buffer
-			resultList = AuditLogList{}
+			c.logger.Debug("waiting for the next audit log poll")
 
-			if !page.ReturnMoreResults() {
-				c.logger.Debug("no more results to return")
-				break
+			timer := time.NewTimer(pollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
 			}
 		}
+	}
+}
 
-		for _, v := range auditlogList.AuditLogs {
-			if !yield(v, nil) {
-				return
-			}
+// Decode the inputs of the audit log entry into the value pointed to by `v`.
+func (l AuditLog) DecodeInputs(v any) error {
+	return json.Unmarshal(l.Inputs, v)
+}
+
+// Decode the outputs of the audit log entry into the value pointed to by `v`.
+func (l AuditLog) DecodeOutputs(v any) error {
+	return json.Unmarshal(l.Outputs, v)
+}
+
+// Decode the details of a StoryCreation audit log entry.
+func (l AuditLog) StoryCreated() (*AuditStoryCreated, error) {
+	e := AuditStoryCreated{}
+
+	err := l.decodeOperationInputs(AuditOpStoryCreated, &e)
+	if err != nil {
+		return nil, err
+	}
+
+	e.StoryID = l.StoryID
+	e.CreatedBy = l.UserID
+	e.CreatedAt = l.CreatedAt
+
+	return &e, nil
+}
+
+// Decode the details of a UserCredentialUpdate audit log entry.
+func (l AuditLog) CredentialUpdated() (*AuditCredentialUpdated, error) {
+	e := AuditCredentialUpdated{}
+
+	err := l.decodeOperationInputs(AuditOpCredentialUpdated, &e)
+	if err != nil {
+		return nil, err
+	}
+
+	e.UpdatedBy = l.UserID
+	e.UpdatedAt = l.CreatedAt
+
+	return &e, nil
+}
+
+// Decode the details of a Login audit log entry.
+func (l AuditLog) UserSignedIn() (*AuditUserSignedIn, error) {
+	err := l.checkOperation(AuditOpUserSignedIn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditUserSignedIn{
+		UserID:     l.UserID,
+		UserEmail:  l.UserEmail,
+		UserName:   l.UserName,
+		RequestIP:  l.RequestIP,
+		RequestUA:  l.RequestUA,
+		SignedInAt: l.CreatedAt,
+	}, nil
+}
+
+// Operation inputs are logged as the arguments of the operation, nested under an "inputs" key.
+func (l AuditLog) decodeOperationInputs(op string, v any) error {
+	err := l.checkOperation(op)
+	if err != nil {
+		return err
+	}
+
+	if len(l.Inputs) == 0 {
+		return nil
+	}
+
+	in := struct {
+		Inputs any `json:"inputs"`
+	}{
+		Inputs: v,
+	}
+
+	err = json.Unmarshal(l.Inputs, &in)
+	if err != nil {
+		return Error{
+			Type: ErrorTypeServer,
+			Errors: []ErrorMessage{
+				{
+					Message: errUnmarshalError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
 		}
 	}
+
+	return nil
+}
+
+func (l AuditLog) checkOperation(op string) error {
+	if l.OperationName != op {
+		return Error{
+			Type: ErrorTypeRequest,
+			Errors: []ErrorMessage{
+				{
+					Message: errParseError,
+					Details: fmt.Sprintf("audit log %d is a %s operation, not %s", l.Id, l.OperationName, op),
+				},
+			},
+		}
+	}
+
+	return nil
 }
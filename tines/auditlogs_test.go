@@ -2,8 +2,16 @@ package tines_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
 	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tines/go-sdk/tines"
@@ -68,3 +76,346 @@ func TestAuditLogsList(t *testing.T) {
 	}
 
 }
+
+// Returns a test server that serves the given number of single-result pages of audit logs.
+func createPagedAuditLogServer(pages int, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			page = 1
+		}
+
+		next, nextNum := "null", "null"
+		if page < pages {
+			next = fmt.Sprintf(`"%s/api/v1/audit_logs?per_page=1&page=%d"`, "https://example.tines.com", page+1)
+			nextNum = strconv.Itoa(page + 1)
+		}
+
+		fmt.Fprintf(w, `{
+			"audit_logs": [{"id": %d, "operation_name": "Action", "created_at": "2025-01-11T03:57:28Z"}],
+			"meta": {"next_page": %s, "next_page_number": %s, "per_page": 1, "pages": %d, "count": %d}
+		}`, page, next, nextNum, pages, pages)
+	}))
+}
+
+func TestAuditLogsListStreaming(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createPagedAuditLogServer(3, &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+	logs := cli.ListAuditLogs(ctx, tines.NewListFilter())
+
+	for l, err := range logs {
+		assert.Nil(err)
+		assert.Equal(1, l.Id)
+		break
+	}
+
+	assert.Equal(int32(1), calls.Load(), "no further pages should be fetched once the consumer stops iterating")
+
+	var ids []int
+	for l, err := range logs {
+		assert.Nil(err)
+		ids = append(ids, l.Id)
+	}
+
+	assert.Equal([]int{1, 2, 3}, ids, "the iterator should restart from the first page and stream every page in order")
+	assert.Equal(int32(4), calls.Load(), "each page should be fetched exactly once per iteration")
+}
+
+func TestAuditLogsListPrefetch(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createPagedAuditLogServer(5, &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+	lf := tines.NewListFilter(
+		tines.WithPagePrefetch(3),
+	)
+
+	var ids []int
+	for l, err := range cli.ListAuditLogs(ctx, lf) {
+		assert.Nil(err)
+		ids = append(ids, l.Id)
+	}
+
+	assert.Equal([]int{1, 2, 3, 4, 5}, ids, "prefetched pages should still be yielded in order")
+	assert.Equal(int32(5), calls.Load(), "each page should be fetched exactly once")
+}
+
+func TestAuditLogsListPages(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createPagedAuditLogServer(3, &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for page, err := range tines.Pages(ctx, cli.ListAuditLogs, tines.NewListFilter()) {
+		assert.Nil(err)
+		assert.Len(page, 1)
+		break
+	}
+
+	assert.Equal(int32(1), calls.Load(), "no further pages should be fetched once the consumer stops iterating")
+
+	var pages [][]int
+	for page, err := range tines.Pages(ctx, cli.ListAuditLogs, tines.NewListFilter(tines.WithMaxResults(2))) {
+		assert.Nil(err)
+		var ids []int
+		for _, l := range page {
+			ids = append(ids, l.Id)
+		}
+		pages = append(pages, ids)
+	}
+
+	assert.Equal([][]int{{1}, {2}}, pages, "each page of results should be yielded together, up to the maximum number of results")
+
+	ignoreFilter := func(ctx context.Context, _ tines.ListFilter) iter.Seq2[tines.AuditLog, error] {
+		return cli.ListAuditLogs(ctx, tines.NewListFilter())
+	}
+
+	calls.Store(0)
+	for page, err := range tines.Pages(ctx, ignoreFilter, tines.NewListFilter()) {
+		var tErr tines.Error
+		assert.ErrorAs(err, &tErr, "a list function that drops the filter should yield an error")
+		assert.Nil(page)
+	}
+	assert.Equal(int32(1), calls.Load(), "the listing should not be buffered when the filter is dropped")
+}
+
+func TestAuditLogsListResume(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createPagedAuditLogServer(4, &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	var token string
+	cur := &tines.ListCursor{}
+	for l, err := range cli.ListAuditLogs(ctx, tines.NewListFilter(tines.WithListCursor(cur))) {
+		assert.Nil(err)
+		token = cur.Token()
+		if l.Id == 2 {
+			break
+		}
+	}
+
+	assert.NotEmpty(token, "the cursor should produce a token once iteration has started")
+
+	restored, err := tines.ParseListCursor(token)
+	assert.Nil(err, "the token should be parsed successfully")
+
+	var ids []int
+	for l, err := range cli.ListAuditLogs(ctx, tines.NewListFilter(tines.WithListCursor(restored))) {
+		assert.Nil(err)
+		ids = append(ids, l.Id)
+	}
+
+	assert.Equal([]int{3, 4}, ids, "the listing should resume just after the last result processed")
+
+	_, err = tines.ParseListCursor("not a valid token!")
+	var tErr tines.Error
+	if assert.ErrorAs(err, &tErr, "an invalid token should fail to parse") {
+		assert.Equal(tines.ErrorTypeRequest, tErr.Type)
+	}
+}
+
+func TestFollowAuditLogs(t *testing.T) {
+	assert := assert.New(t)
+
+	var afters, befores []string
+	polls := []string{
+		`[{"id": 2, "created_at": "2025-01-11T03:57:29Z"}, {"id": 1, "created_at": "2025-01-11T03:57:28Z"}]`,
+		`[{"id": 3, "created_at": "2025-01-11T03:57:30Z"}, {"id": 2, "created_at": "2025-01-11T03:57:29Z"}]`,
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		afters = append(afters, r.URL.Query().Get("after"))
+		befores = append(befores, r.URL.Query().Get("before"))
+
+		logs := "[]"
+		if len(afters) <= len(polls) {
+			logs = polls[len(afters)-1]
+		}
+		fmt.Fprintf(w, `{"audit_logs": %s, "meta": {"next_page": null, "next_page_number": null}}`, logs)
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var ids []int
+	lf := tines.NewListFilter(
+		tines.WithResultsAfter("2025-01-01"),
+		tines.WithResultsBefore("2025-01-02"),
+	)
+
+	for l, err := range cli.FollowAuditLogs(ctx, lf, time.Millisecond) {
+		assert.Nil(err)
+		ids = append(ids, l.Id)
+		if l.Id == 3 {
+			cancel()
+		}
+	}
+
+	assert.Equal([]int{1, 2, 3}, ids, "new audit logs should be yielded once each, in chronological order")
+	if assert.GreaterOrEqual(len(afters), 2) {
+		assert.Equal("2025-01-01T00:00:00Z", afters[0], "the first poll should start from the requested point")
+		assert.Equal("2025-01-11T03:57:29Z", afters[1], "later polls should start from the newest audit log seen")
+		assert.Equal([]string{""}, slices.Compact(befores), "the before filter should be cleared so that new audit logs are returned")
+	}
+}
+
+func TestFollowAuditLogsStartsNow(t *testing.T) {
+	assert := assert.New(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var after string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		after = r.URL.Query().Get("after")
+		cancel()
+		fmt.Fprint(w, `{"audit_logs": [], "meta": {"next_page": null, "next_page_number": null}}`)
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	for _, err := range cli.FollowAuditLogs(ctx, tines.NewListFilter(), time.Millisecond) {
+		assert.Nil(err)
+	}
+
+	start, err := time.Parse(time.RFC3339, after)
+	assert.Nil(err, "the first poll should set a start point")
+	assert.WithinDuration(time.Now(), start, 5*time.Second, "following should start from the current time instead of downloading the whole audit log")
+}
+
+func TestAuditLogDecodeHelpers(t *testing.T) {
+	assert := assert.New(t)
+
+	var logs []tines.AuditLog
+	err := json.Unmarshal([]byte(`[
+		{
+			"id": 1,
+			"operation_name": "StoryCreation",
+			"created_at": "2025-01-11T03:57:28Z",
+			"inputs": {"inputs": {"name": "Test Story", "teamId": 2, "folderId": 3}},
+			"outputs": {},
+			"story_id": 4,
+			"user_id": 5
+		},
+		{
+			"id": 2,
+			"operation_name": "UserCredentialUpdate",
+			"created_at": "2025-01-11T03:57:29Z",
+			"inputs": {"inputs": {"id": 6, "name": "Test Credential", "mode": "TEXT"}},
+			"outputs": {},
+			"user_id": 5
+		},
+		{
+			"id": 3,
+			"operation_name": "Login",
+			"created_at": "2025-01-11T03:57:30Z",
+			"inputs": null,
+			"outputs": null,
+			"request_ip": "1.1.1.1",
+			"user_email": "user@example.com",
+			"user_id": 5
+		}
+	]`), &logs)
+	assert.Nil(err, "the audit logs should be parsed successfully")
+	if !assert.Len(logs, 3) {
+		return
+	}
+
+	story, err := logs[0].StoryCreated()
+	if assert.Nil(err, "the story creation should be decoded successfully") {
+		assert.Equal(4, story.StoryID)
+		assert.Equal("Test Story", story.Name)
+		assert.Equal(2, story.TeamID)
+		assert.Equal(3, story.FolderID)
+		assert.Equal(5, story.CreatedBy)
+		assert.Equal(2025, story.CreatedAt.Year())
+	}
+
+	cred, err := logs[1].CredentialUpdated()
+	if assert.Nil(err, "the credential update should be decoded successfully") {
+		assert.Equal(6, cred.CredentialID)
+		assert.Equal(tines.CredentialTypeText, cred.Mode)
+		assert.Equal(5, cred.UpdatedBy)
+	}
+
+	login, err := logs[2].UserSignedIn()
+	if assert.Nil(err, "the sign in should be decoded successfully") {
+		assert.Equal("user@example.com", login.UserEmail)
+		assert.Equal("1.1.1.1", login.RequestIP)
+	}
+
+	_, err = logs[2].StoryCreated()
+	assert.NotNil(err, "decoding an audit log as the wrong operation should fail")
+}
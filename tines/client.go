@@ -6,22 +6,31 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
+	"github.com/tines/go-sdk/internal/logging"
 	"github.com/tines/go-sdk/internal/utils"
 	"go.uber.org/zap"
 )
 
 type Client struct {
-	tenantUrl  string
-	apiKey     string
-	userAgent  string
-	httpClient *http.Client
-	logger     *zap.Logger
+	tenantUrl   string
+	apiKey      string
+	userAgent   string
+	httpClient  *http.Client
+	logger      logging.Logger
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
+	transport   http.RoundTripper
+	middleware  []Middleware
+	telemetry   Telemetry
+	wireLogging bool
 }
 
 // Create a new Tines API client. The Tenant URL and Tines API Key
@@ -38,7 +47,7 @@ type Client struct {
 func NewClient(opts ...func(*Client)) (*Client, error) {
 	c := Client{
 		httpClient: &http.Client{},
-		logger:     zap.NewNop(),
+		logger:     logging.Nop(),
 	}
 	errs := Error{Type: ErrorTypeRequest}
 
@@ -67,6 +76,8 @@ func NewClient(opts ...func(*Client)) (*Client, error) {
 		ua(&c)
 	}
 
+	c.buildHttpClient()
+
 	// We do additional error checking when crafting the HTTP request to
 	// ensure it goes to a valid URL, but we should at least make sure
 	// the identified tenant URL starts with a valid protocol since that
@@ -118,26 +129,71 @@ func SetUserAgent(s string) func(*Client) {
 // by the SDK using your application's existing error-handling logic.
 func SetLogger(l *zap.Logger) func(*Client) {
 	return func(c *Client) {
-		c.logger = l
+		c.logger = logging.NewZap(l)
+	}
+}
+
+// You may optionally pass a configured slog logger instead of a zap logger when creating a new client. As
+// with SetLogger(), the Go SDK only emits logs at a DEBUG level.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetSlogLogger(slog.Default()),
+//	)
+func SetSlogLogger(l *slog.Logger) func(*Client) {
+	return func(c *Client) {
+		c.logger = logging.NewSlog(l)
 	}
 }
 
 func (c *Client) doRequest(ctx context.Context, method, path string, params map[string]any, data []byte) ([]byte, error) {
+	if c.telemetry == nil {
+		body, _, err := c.sendWithRetries(ctx, method, path, params, data)
+		return body, err
+	}
+
+	op := operationFromContext(ctx)
+	ctx, span := c.telemetry.StartSpan(ctx, op)
+	defer span.End()
+
+	start := time.Now()
+	body, stats, err := c.sendWithRetries(ctx, method, path, params, data)
+	c.recordRequest(ctx, span, op, method, path, stats, time.Since(start), err)
+
+	return body, err
+}
+
+// The outcome of the attempts made by sendWithRetries(), for telemetry.
+type requestStats struct {
+	attempts   int
+	statusCode int
+}
+
+// Build the request URL and send it, retrying according to the client's RetryPolicy.
+func (c *Client) sendWithRetries(ctx context.Context, method, path string, params map[string]any, data []byte) ([]byte, requestStats, error) {
+	var stats requestStats
+
 	tenant, err := url.Parse(c.tenantUrl)
 	if err != nil {
-		return nil, Error{
-			Type: ErrorTypeRequest,
+		return nil, stats, Error{
+			Type:   ErrorTypeRequest,
+			Method: method,
+			Path:   path,
 			Errors: []ErrorMessage{
 				{
 					Message: errParseError,
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
 	for k, v := range params {
-		c.logger.Debug("found param", zap.Any(k, v))
+		c.logger.Debug("found param", logging.Any(k, v))
 	}
 
 	q := tenant.Query()
@@ -148,44 +204,120 @@ func (c *Client) doRequest(ctx context.Context, method, path string, params map[
 			if ok {
 				v = strconv.Itoa(i)
 			} else {
-				c.logger.Debug("unable to convert int value to string, skipping", zap.Any(k, v))
+				c.logger.Debug("unable to convert int value to string, skipping", logging.Any(k, v))
 			}
 		case reflect.TypeFor[float64]():
 			f, ok := v.(float64)
 			if ok {
 				v = strconv.FormatFloat(f, 'f', 0, 64)
 			} else {
-				c.logger.Debug("unable to convert float64 value to string, skipping", zap.Any(k, v))
+				c.logger.Debug("unable to convert float64 value to string, skipping", logging.Any(k, v))
 			}
 		case reflect.TypeFor[bool]():
 			b, ok := v.(bool)
 			if ok {
 				v = strconv.FormatBool(b)
 			} else {
-				c.logger.Debug("unable to convert bool value to string, skipping", zap.Any(k, v))
+				c.logger.Debug("unable to convert bool value to string, skipping", logging.Any(k, v))
 			}
 		}
 
 		s, ok := v.(string)
 		if ok {
-			c.logger.Debug(fmt.Sprintf("setting query param %s to value %s", k, v))
+			c.logger.Debug("setting query param", logging.Any("param", k), logging.Any("value", s))
 			q.Add(k, s)
 		} else {
-			c.logger.Debug("invalid string value, skipping", zap.Any(k, v))
+			c.logger.Debug("invalid string value, skipping", logging.Any(k, v))
 		}
 	}
 
-	c.logger.Debug(fmt.Sprintf("final query string: %s", q.Encode()))
+	c.logger.Debug("final query string", logging.Any("query", q.Encode()))
 
 	fullUrl := tenant.JoinPath(path)
 	fullUrl.RawQuery = q.Encode()
 
-	c.logger.Debug(fmt.Sprintf("sending request to url %s", fullUrl.String()))
+	for attempt := 1; ; attempt++ {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			c.logger.Debug("rate limiter did not allow the request", logging.Err(err))
+			// A caller cancelling the request is not a rate limit; that is reserved for
+			// deadlines that would pass before a token becomes available.
+			if ctx.Err() != nil {
+				return nil, stats, Error{
+					Type:   ErrorTypeRequest,
+					Method: method,
+					Path:   path,
+					Errors: []ErrorMessage{
+						{
+							Message: errDoRequestError,
+							Details: ctx.Err().Error(),
+						},
+					},
+					Err: ctx.Err(),
+				}
+			}
+			return nil, stats, Error{
+				Type:   ErrorTypeRateLimit,
+				Method: method,
+				Path:   path,
+				Errors: []ErrorMessage{
+					{
+						Message: errRateLimitError,
+						Details: err.Error(),
+					},
+				},
+				Err: err,
+			}
+		}
+
+		c.logger.Debug("sending request", logging.Any("method", method), logging.Any("url", fullUrl.String()))
+
+		body, resp, err := c.sendRequest(ctx, method, fullUrl.String(), data)
+		stats.attempts = attempt
+		if resp != nil {
+			stats.statusCode = resp.StatusCode
+			c.rateLimiter.update(resp.Header)
+		}
+		if err == nil {
+			return body, stats, nil
+		}
+
+		err = annotateError(err, method, path, resp)
+
+		if !c.retryPolicy.retryable(ctx, attempt, method, resp, err) {
+			return nil, stats, err
+		}
+
+		wait := c.retryPolicy.backoff(attempt, resp)
+		c.logger.Debug("retrying request", logging.Any("wait", wait), logging.Any("attempt", attempt), logging.Err(err))
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, stats, Error{
+				Type:   ErrorTypeRequest,
+				Method: method,
+				Path:   path,
+				Errors: []ErrorMessage{
+					{
+						Message: errDoRequestError,
+						Details: ctx.Err().Error(),
+					},
+				},
+				Err: ctx.Err(),
+			}
+		case <-timer.C:
+		}
+	}
+}
 
-	req, err := http.NewRequestWithContext(ctx, method, fullUrl.String(), bytes.NewBuffer(data))
+// Make a single attempt at an HTTP request. The raw response is returned alongside any error
+// so that the retry policy can inspect the status code and headers.
+func (c *Client) sendRequest(ctx context.Context, method, fullUrl string, data []byte) ([]byte, *http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fullUrl, bytes.NewBuffer(data))
 	if err != nil {
 		c.logger.Debug(err.Error())
-		return nil, Error{
+		return nil, nil, Error{
 			Type: ErrorTypeRequest,
 			Errors: []ErrorMessage{
 				{
@@ -193,6 +325,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, params map[
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -201,9 +334,11 @@ func (c *Client) doRequest(ctx context.Context, method, path string, params map[
 	req.Header.Set("User-Agent", utils.SetUserAgent(c.userAgent))
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 
+	start := time.Now()
 	resp, respErr := c.httpClient.Do(req)
 	if respErr != nil {
-		return nil, Error{
+		c.logWire(req, data, nil, nil, time.Since(start), respErr)
+		return nil, nil, Error{
 			Type: ErrorTypeRequest,
 			Errors: []ErrorMessage{
 				{
@@ -211,15 +346,17 @@ func (c *Client) doRequest(ctx context.Context, method, path string, params map[
 					Details: respErr.Error(),
 				},
 			},
+			Err: respErr,
 		}
 	}
 
 	defer resp.Body.Close()
 
 	body, readErr := io.ReadAll(resp.Body)
+	c.logWire(req, data, resp, body, time.Since(start), readErr)
 	if readErr != nil {
 		c.logger.Debug(readErr.Error())
-		return nil, Error{
+		return nil, resp, Error{
 			Type:       ErrorTypeServer,
 			StatusCode: resp.StatusCode,
 			Errors: []ErrorMessage{
@@ -228,34 +365,39 @@ func (c *Client) doRequest(ctx context.Context, method, path string, params map[
 					Details: readErr.Error(),
 				},
 			},
+			Err: readErr,
 		}
 	}
 
-	// Return a server error for 5XX responses
-	if resp.StatusCode >= http.StatusInternalServerError {
+	// Return a typed error for 4XX and 5XX responses
+	if resp.StatusCode >= http.StatusBadRequest {
 		errMsgs := c.getErrorMessages(body)
 
-		c.logger.Debug(fmt.Sprintf("received a %d status code from the server", resp.StatusCode))
-		return nil, Error{
-			Type:       ErrorTypeServer,
+		c.logger.Debug("received an error status code from the server", logging.Any("status", resp.StatusCode))
+		return nil, resp, Error{
+			Type:       errorTypeForStatus(resp.StatusCode),
 			StatusCode: resp.StatusCode,
 			Errors:     errMsgs,
 		}
 	}
 
-	// Return a request error for 4XX responses
-	if resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError {
-		errMsgs := c.getErrorMessages(body)
+	return body, resp, nil
+}
 
-		c.logger.Debug(fmt.Sprintf("received a %d status code from the server", resp.StatusCode))
-		return nil, Error{
-			Type:       ErrorTypeRequest,
-			StatusCode: resp.StatusCode,
-			Errors:     errMsgs,
-		}
+// Attach the details of the request that failed to an Error returned by sendRequest().
+func annotateError(err error, method, path string, resp *http.Response) error {
+	tErr, ok := err.(Error)
+	if !ok {
+		return err
+	}
+
+	tErr.Method = method
+	tErr.Path = path
+	if resp != nil {
+		tErr.RequestID = resp.Header.Get("X-Request-Id")
 	}
 
-	return body, nil
+	return tErr
 }
 
 func (c *Client) getErrorMessages(body []byte) []ErrorMessage {
@@ -37,7 +37,22 @@ func TestClientError(t *testing.T) {
 }
 
 func createTestServer(assert *assert.Assertions, expectRespStatus int, expectReqBody, expectRespBody []byte) *httptest.Server {
-	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return httptest.NewServer(createTestHandler(assert, expectRespStatus, expectReqBody, expectRespBody))
+}
+
+// Like createTestServer, but also validates that the client calls the expected endpoint with the
+// expected HTTP method.
+func createRouteTestServer(assert *assert.Assertions, expectMethod, expectPath string, expectRespStatus int, expectReqBody, expectRespBody []byte) *httptest.Server {
+	handler := createTestHandler(assert, expectRespStatus, expectReqBody, expectRespBody)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(expectMethod, r.Method, "client should use the expected HTTP method")
+		assert.Equal(expectPath, r.URL.Path, "client should call the expected endpoint")
+		handler(w, r)
+	}))
+}
+
+func createTestHandler(assert *assert.Assertions, expectRespStatus int, expectReqBody, expectRespBody []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
 		// Validate that the client is sending expected request values
 		assert.Equal("application/json", r.Header.Get("Content-Type"), "client should send JSON data")
 		assert.Equal("application/json", r.Header.Get("Accept"))
@@ -57,6 +72,5 @@ func createTestServer(assert *assert.Assertions, expectRespStatus int, expectReq
 		w.WriteHeader(expectRespStatus)
 
 		w.Write(expectRespBody) //nolint:errcheck
-	}))
-	return ts
+	}
 }
@@ -0,0 +1,304 @@
+package tines
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by NewClientFromEnv().
+const (
+	EnvTenantUrl  = "TINES_TENANT_URL"
+	EnvApiKey     = "TINES_API_KEY"
+	EnvProfile    = "TINES_PROFILE"
+	EnvConfigFile = "TINES_CONFIG_FILE"
+)
+
+const defaultProfileName = "default"
+
+// The contents of a Tines config file, which holds connection settings for several named
+// tenants.
+//
+// Example config file:
+//
+//	default_profile: dev
+//	profiles:
+//	  dev:
+//	    tenant_url: https://dev-example.tines.com/
+//	    api_key: foo
+//	  prod:
+//	    tenant_url: https://example.tines.com/
+//	    api_key: bar
+//	    user_agent: my-app/1.0
+//	    timeout: 30s
+//	    retry:
+//	      max_attempts: 5
+//	      min_backoff: 1s
+//	    rate_limit:
+//	      requests_per_second: 5
+//	      burst: 10
+type Config struct {
+	// The profile used when no profile name is given. Defaults to "default".
+	DefaultProfile string             `yaml:"default_profile"`
+	Profiles       map[string]Profile `yaml:"profiles"`
+}
+
+// The connection settings for a single tenant.
+type Profile struct {
+	TenantUrl string `yaml:"tenant_url"`
+	ApiKey    string `yaml:"api_key"`
+	UserAgent string `yaml:"user_agent"`
+	// The time limit for each HTTP request, as a duration string such as "30s".
+	Timeout   time.Duration     `yaml:"timeout"`
+	Retry     *ProfileRetry     `yaml:"retry"`
+	RateLimit *ProfileRateLimit `yaml:"rate_limit"`
+}
+
+// The retry settings for a Profile. See RetryPolicy.
+type ProfileRetry struct {
+	MaxAttempts int           `yaml:"max_attempts"`
+	MinBackoff  time.Duration `yaml:"min_backoff"`
+	MaxBackoff  time.Duration `yaml:"max_backoff"`
+}
+
+// The client-side rate limit settings for a Profile. See NewRateLimiter(). A RequestsPerSecond
+// of zero applies no client-side limit, but still pauses requests when the server-side limit has
+// been used up.
+type ProfileRateLimit struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+}
+
+// Returns the path of the Tines config file: the value of TINES_CONFIG_FILE if it is set, and
+// otherwise tines/config.yaml in $XDG_CONFIG_HOME or ~/.config.
+func DefaultConfigPath() string {
+	if p := os.Getenv(EnvConfigFile); p != "" {
+		return p
+	}
+
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "tines", "config.yaml")
+}
+
+// Load a Tines config file.
+func LoadConfig(path string) (*Config, error) {
+	cfg := Config{}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, configError(err)
+	}
+
+	err = yaml.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, configError(err)
+	}
+
+	for name, p := range cfg.Profiles {
+		if err := p.validate(); err != nil {
+			return nil, configError(fmt.Errorf("profile %q: %w", name, err))
+		}
+	}
+
+	return &cfg, nil
+}
+
+// Returns the named profile, or the default profile if `name` is empty.
+func (cfg *Config) Profile(name string) (Profile, error) {
+	if name == "" {
+		name = cfg.DefaultProfile
+	}
+	if name == "" {
+		name = defaultProfileName
+	}
+
+	p, ok := cfg.Profiles[name]
+	if !ok {
+		return Profile{}, configError(fmt.Errorf("profile %q not found", name))
+	}
+
+	return p, nil
+}
+
+func (p Profile) validate() error {
+	if p.Timeout < 0 {
+		return errors.New("timeout must not be negative")
+	}
+
+	if r := p.Retry; r != nil {
+		if r.MaxAttempts < 0 {
+			return errors.New("retry.max_attempts must not be negative")
+		}
+		if r.MinBackoff < 0 || r.MaxBackoff < 0 {
+			return errors.New("retry backoff durations must not be negative")
+		}
+		if r.MaxBackoff > 0 && r.MaxBackoff < r.MinBackoff {
+			return errors.New("retry.max_backoff must not be less than retry.min_backoff")
+		}
+	}
+
+	if r := p.RateLimit; r != nil {
+		if r.RequestsPerSecond < 0 {
+			return errors.New("rate_limit.requests_per_second must not be negative")
+		}
+		if r.Burst < 0 {
+			return errors.New("rate_limit.burst must not be negative")
+		}
+	}
+
+	return nil
+}
+
+type profileLimiterKey struct {
+	tenantUrl string
+	rateLimit ProfileRateLimit
+}
+
+// The RateLimiters created for profiles, so that every Client created from the same profile
+// shares a single request budget.
+var (
+	profileLimitersMu sync.Mutex
+	profileLimiters   = map[profileLimiterKey]*RateLimiter{}
+)
+
+func (p Profile) rateLimiter() *RateLimiter {
+	key := profileLimiterKey{tenantUrl: p.TenantUrl, rateLimit: *p.RateLimit}
+
+	profileLimitersMu.Lock()
+	defer profileLimitersMu.Unlock()
+
+	l, ok := profileLimiters[key]
+	if !ok {
+		l = NewRateLimiter(p.RateLimit.RequestsPerSecond, p.RateLimit.Burst)
+		profileLimiters[key] = l
+	}
+
+	return l
+}
+
+// Returns the client options that apply the settings of the profile. Settings that are not set
+// in the profile are left at their defaults. Clients created from profiles with the same tenant
+// URL and rate limit settings share a single RateLimiter for the life of the process.
+func (p Profile) Options() []func(*Client) {
+	var opts []func(*Client)
+
+	if p.TenantUrl != "" {
+		opts = append(opts, SetTenantUrl(p.TenantUrl))
+	}
+
+	if p.ApiKey != "" {
+		opts = append(opts, SetApiKey(p.ApiKey))
+	}
+
+	if p.UserAgent != "" {
+		opts = append(opts, SetUserAgent(p.UserAgent))
+	}
+
+	if p.Timeout > 0 {
+		opts = append(opts, SetHttpClient(&http.Client{Timeout: p.Timeout}))
+	}
+
+	if p.Retry != nil {
+		opts = append(opts, SetRetryPolicy(RetryPolicy{
+			MaxAttempts: p.Retry.MaxAttempts,
+			MinBackoff:  p.Retry.MinBackoff,
+			MaxBackoff:  p.Retry.MaxBackoff,
+		}))
+	}
+
+	if p.RateLimit != nil {
+		opts = append(opts, SetRateLimiter(p.rateLimiter()))
+	}
+
+	return opts
+}
+
+// Create a new Tines API client from the environment. Settings are applied in order of
+// precedence, from lowest to highest:
+//
+//  1. The profile named by TINES_PROFILE (or the config file's default profile) in the config
+//     file at DefaultConfigPath(), if the file exists.
+//  2. The TINES_TENANT_URL and TINES_API_KEY environment variables.
+//  3. The options passed to this function.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClientFromEnv(
+//	  tines.SetUserAgent("my-app/1.0"),
+//	)
+func NewClientFromEnv(opts ...func(*Client)) (*Client, error) {
+	var all []func(*Client)
+
+	cfg, err := LoadConfig(DefaultConfigPath())
+	switch {
+	case err == nil:
+		p, err := cfg.Profile(os.Getenv(EnvProfile))
+		if err != nil && os.Getenv(EnvProfile) != "" {
+			return nil, err
+		}
+		all = append(all, p.Options()...)
+	case errors.Is(err, fs.ErrNotExist):
+		// Running without a config file is fine as long as the environment is set.
+	default:
+		return nil, err
+	}
+
+	if v := os.Getenv(EnvTenantUrl); v != "" {
+		all = append(all, SetTenantUrl(v))
+	}
+
+	if v := os.Getenv(EnvApiKey); v != "" {
+		all = append(all, SetApiKey(v))
+	}
+
+	return NewClient(append(all, opts...)...)
+}
+
+// Create a new Tines API client from a named profile in the config file at DefaultConfigPath().
+// An empty name selects the config file's default profile. Options passed to this function
+// override the profile's settings.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClientFromProfile("prod")
+func NewClientFromProfile(name string, opts ...func(*Client)) (*Client, error) {
+	cfg, err := LoadConfig(DefaultConfigPath())
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := cfg.Profile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(append(p.Options(), opts...)...)
+}
+
+func configError(err error) error {
+	return Error{
+		Type: ErrorTypeRequest,
+		Errors: []ErrorMessage{
+			{
+				Message: errConfigError,
+				Details: err.Error(),
+			},
+		},
+		Err: err,
+	}
+}
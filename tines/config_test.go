@@ -0,0 +1,238 @@
+package tines_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const testConfigFile = `
+default_profile: dev
+profiles:
+  dev:
+    tenant_url: https://dev.example.invalid/
+    api_key: bar
+  prod:
+    tenant_url: %s
+    api_key: foo
+    timeout: 30s
+    retry:
+      max_attempts: 3
+      min_backoff: 1s
+    rate_limit:
+      requests_per_second: 5
+      burst: 10
+`
+
+// Write a config file to a temporary directory and point TINES_CONFIG_FILE at it.
+func writeTestConfig(t *testing.T, tenantUrl string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := []byte(fmt.Sprintf(testConfigFile, tenantUrl))
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(tines.EnvConfigFile, path)
+	t.Setenv(tines.EnvTenantUrl, "")
+	t.Setenv(tines.EnvApiKey, "")
+	t.Setenv(tines.EnvProfile, "")
+
+	return path
+}
+
+func TestLoadConfig(t *testing.T) {
+	assert := assert.New(t)
+	path := writeTestConfig(t, "https://example.invalid/")
+
+	cfg, err := tines.LoadConfig(path)
+	assert.Nil(err, "the config file should be loaded successfully")
+	if err != nil {
+		return
+	}
+
+	p, err := cfg.Profile("")
+	assert.Nil(err)
+	assert.Equal("https://dev.example.invalid/", p.TenantUrl, "the default profile should be selected when no name is given")
+
+	p, err = cfg.Profile("prod")
+	assert.Nil(err)
+	assert.Equal(30*time.Second, p.Timeout)
+	if assert.NotNil(p.Retry) {
+		assert.Equal(3, p.Retry.MaxAttempts)
+		assert.Equal(time.Second, p.Retry.MinBackoff)
+	}
+	if assert.NotNil(p.RateLimit) {
+		assert.Equal(5.0, p.RateLimit.RequestsPerSecond)
+		assert.Equal(10, p.RateLimit.Burst)
+	}
+
+	_, err = cfg.Profile("staging")
+	assert.NotNil(err, "a missing profile should return an error")
+}
+
+func TestNewClientFromProfile(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	writeTestConfig(t, ts.URL)
+
+	cli, err := tines.NewClientFromProfile("prod")
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+	assert.Nil(err, "the client should use the profile's tenant and API key")
+
+	_, err = tines.NewClientFromProfile("staging")
+	assert.NotNil(err, "a missing profile should return an error")
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	t.Setenv(tines.EnvConfigFile, filepath.Join(t.TempDir(), "missing.yaml"))
+	t.Setenv(tines.EnvProfile, "")
+	t.Setenv(tines.EnvTenantUrl, ts.URL)
+	t.Setenv(tines.EnvApiKey, "foo")
+
+	cli, err := tines.NewClientFromEnv()
+	assert.Nil(err, "the client should be created from the environment without a config file")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+	assert.Nil(err)
+}
+
+func TestNewClientFromEnvPrecedence(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	writeTestConfig(t, ts.URL)
+
+	// The default "dev" profile has the wrong tenant and API key: the environment overrides
+	// the tenant, and an explicit option overrides the API key.
+	t.Setenv(tines.EnvTenantUrl, ts.URL)
+
+	cli, err := tines.NewClientFromEnv(
+		tines.SetApiKey("foo"),
+	)
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+	assert.Nil(err, "environment variables and explicit options should override the config file")
+
+	t.Setenv(tines.EnvProfile, "staging")
+	_, err = tines.NewClientFromEnv()
+	assert.NotNil(err, "selecting a missing profile should return an error")
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		name    string
+		profile string
+	}{
+		{"NegativeRate", "rate_limit: {requests_per_second: -1}"},
+		{"NegativeBurst", "rate_limit: {requests_per_second: 5, burst: -1}"},
+		{"NegativeAttempts", "retry: {max_attempts: -1}"},
+		{"NegativeBackoff", "retry: {min_backoff: -1s}"},
+		{"InvertedBackoff", "retry: {min_backoff: 10s, max_backoff: 1s}"},
+		{"NegativeTimeout", "timeout: -1s"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			content := fmt.Sprintf("profiles:\n  dev:\n    tenant_url: https://example.invalid/\n    %s\n", test.profile)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := tines.LoadConfig(path)
+
+			var tErr tines.Error
+			if assert.ErrorAs(err, &tErr, "an invalid profile should fail to load") {
+				assert.Contains(tErr.Error(), `profile "dev"`, "the error should name the invalid profile")
+			}
+		})
+	}
+}
+
+func TestLoadConfigWithoutRate(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testGetInfoResp))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := fmt.Sprintf("profiles:\n  default:\n    tenant_url: %s\n    api_key: foo\n    rate_limit: {requests_per_second: 0, burst: 1}\n", ts.URL)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(tines.EnvConfigFile, path)
+
+	cli, err := tines.NewClientFromProfile("")
+	assert.Nil(err, "a profile without a client-side request rate should be valid")
+	if err != nil {
+		return
+	}
+
+	start := time.Now()
+
+	for range 5 {
+		_, err = cli.GetInfo(context.Background())
+		assert.Nil(err)
+	}
+
+	assert.Less(time.Since(start), time.Second, "a request rate of zero should not throttle requests")
+}
+
+func TestNewClientFromProfileSharesRateLimiter(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testGetInfoResp))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	content := fmt.Sprintf("profiles:\n  default:\n    tenant_url: %s\n    api_key: foo\n    rate_limit: {requests_per_second: 20, burst: 1}\n", ts.URL)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(tines.EnvConfigFile, path)
+
+	start := time.Now()
+
+	for range 2 {
+		cli, err := tines.NewClientFromProfile("")
+		assert.Nil(err, "the Tines CLI client should instantiate successfully")
+		if err != nil {
+			return
+		}
+
+		for range 2 {
+			_, err = cli.GetInfo(context.Background())
+			assert.Nil(err)
+		}
+	}
+
+	// One request is allowed immediately, and the other three are spaced 50ms apart.
+	assert.GreaterOrEqual(time.Since(start), 140*time.Millisecond, "clients created from the same profile should share a rate limiter")
+}
@@ -36,8 +36,8 @@ type Credential struct {
 	ReadAccess      string         `json:"read_access,omitempty"`
 	SharedTeams     []string       `json:"shared_team_slugs,omitempty"`
 	Slug            string         `json:"slug,omitempty"`
-	CreatedAt       string         `json:"created_at,omitempty"`
-	UpdatedAt       string         `json:"updated_at,omitempty"`
+	CreatedAt       Time           `json:"created_at,omitzero"`
+	UpdatedAt       Time           `json:"updated_at,omitzero"`
 	Description     string         `json:"description,omitempty"`
 	AwsAssumeRole   string         `json:"aws_assumed_role_external_id,omitempty"`
 	Metadata        map[string]any `json:"metadata,omitempty"`
@@ -112,6 +112,7 @@ type CredentialMultiReq struct {
 }
 
 func (c *Client) CreateCredential(ctx context.Context, cred *Credential) (*Credential, error) {
+	ctx = withOperation(ctx, "CreateCredential")
 	resource := "/api/v1/user_credentials"
 	newCred := Credential{}
 
@@ -125,6 +126,7 @@ func (c *Client) CreateCredential(ctx context.Context, cred *Credential) (*Crede
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -143,6 +145,7 @@ func (c *Client) CreateCredential(ctx context.Context, cred *Credential) (*Crede
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -151,20 +154,13 @@ func (c *Client) CreateCredential(ctx context.Context, cred *Credential) (*Crede
 }
 
 func (c *Client) GetCredential(ctx context.Context, id int) (*Credential, error) {
+	ctx = withOperation(ctx, "GetCredential")
 	resource := fmt.Sprintf("/api/v1/user_credentials/%d", id)
 	cred := Credential{}
 
 	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
 	if err != nil {
-		return &cred, Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return &cred, err
 	}
 
 	err = json.Unmarshal(body, &cred)
@@ -177,6 +173,7 @@ func (c *Client) GetCredential(ctx context.Context, id int) (*Credential, error)
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -184,6 +181,7 @@ func (c *Client) GetCredential(ctx context.Context, id int) (*Credential, error)
 }
 
 func (c *Client) UpdateCredential(ctx context.Context, id int, cred *Credential) (*Credential, error) {
+	ctx = withOperation(ctx, "UpdateCredential")
 	resource := fmt.Sprintf("/api/v1/user_credentials/%d", id)
 	errs := Error{Type: ErrorTypeRequest}
 	updatedCred := Credential{}
@@ -216,20 +214,13 @@ func (c *Client) UpdateCredential(ctx context.Context, id int, cred *Credential)
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
 	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, req)
 	if err != nil {
-		return &updatedCred, Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return &updatedCred, err
 	}
 
 	err = json.Unmarshal(body, &updatedCred)
@@ -242,6 +233,7 @@ func (c *Client) UpdateCredential(ctx context.Context, id int, cred *Credential)
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -249,57 +241,14 @@ func (c *Client) UpdateCredential(ctx context.Context, id int, cred *Credential)
 }
 
 func (c *Client) ListCredentials(ctx context.Context, f ListFilter) iter.Seq2[Credential, error] {
-	var credentialList, resultList CredentialList
+	ctx = withOperation(ctx, "ListCredentials")
 	resource := "/api/v1/user_credentials"
-	params := f.ToParamMap()
-	page := paginate.Cursor{
-		TotalRequested: f.MaxResults(),
-	}
-	return func(yield func(Credential, error) bool) {
-
-		for !page.MaxResultsReturned() {
-			res, err := c.doRequest(ctx, http.MethodGet, resource, params, nil)
-			if err != nil {
-				yield(Credential{}, err)
-				return
-			}
-
-			err = json.Unmarshal(res, &resultList)
-			if err != nil {
-				yield(Credential{}, err)
-				return
-			}
-
-			page.UpdatePagination(resultList.Meta)
-			params = page.GetNextPageParams()
-
-			for _, v := range resultList.UserCredentials {
-				credentialList.UserCredentials = append(credentialList.UserCredentials, v)
-				page.IncrementCounter()
-				if page.MaxResultsReturned() {
-					c.logger.Debug("hit the limit of results to return")
-					break
-				}
-			}
-
-			// Clear the temporary result buffer
-			resultList = CredentialList{}
-
-			if !page.ReturnMoreResults() {
-				c.logger.Debug("no more results to return")
-				break
-			}
-		}
 
-		for _, v := range credentialList.UserCredentials {
-			if !yield(v, nil) {
-				return
-			}
-		}
-	}
+	return newList[Credential](c, resource, "user_credentials", f).All(ctx)
 }
 
 func (c *Client) DeleteCredential(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteCredential")
 	resource := fmt.Sprintf("/api/v1/user_credentials/%d", id)
 
 	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
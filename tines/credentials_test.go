@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tines/go-sdk/tines"
@@ -301,13 +300,10 @@ func TestUpdateCredential(t *testing.T) {
 	assert.Nil(err, "the credential should be retrieved without errors")
 	assert.IsType(&tines.Credential{}, cred, "the response should be the expected type")
 
-	created, err := time.Parse(time.RFC3339, cred.CreatedAt)
-	assert.Nil(err, "the credential creation timestamp should be valid")
+	assert.False(cred.CreatedAt.IsZero(), "the credential creation timestamp should be valid")
+	assert.False(cred.UpdatedAt.IsZero(), "the credential update timestamp should be valid")
 
-	updated, err := time.Parse(time.RFC3339, cred.UpdatedAt)
-	assert.Nil(err, "the credential update timestamp should be valid")
-
-	assert.Greater(updated, created, "the credential update time should be more recent than the creation time")
+	assert.True(cred.UpdatedAt.After(cred.CreatedAt.Time), "the credential update time should be more recent than the creation time")
 
 }
 
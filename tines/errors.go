@@ -1,7 +1,9 @@
 package tines
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -14,6 +16,8 @@ const (
 	errUnmarshalError      = "error unmarshalling the JSON response"
 	errReadBodyError       = "error reading the HTTP response body bytes"
 	errParseError          = "error parsing the input"
+	errRateLimitError      = "error waiting for the client rate limiter"
+	errConfigError         = "error loading the Tines config file"
 )
 
 type ErrorType string
@@ -23,14 +27,51 @@ const (
 	ErrorTypeAuthentication ErrorType = "authentication"
 	ErrorTypeAuthorization  ErrorType = "authorization"
 	ErrorTypeNotFound       ErrorType = "not_found"
+	ErrorTypeConflict       ErrorType = "conflict"
+	ErrorTypeValidation     ErrorType = "validation"
 	ErrorTypeRateLimit      ErrorType = "rate_limit"
 	ErrorTypeServer         ErrorType = "server"
 )
 
+// Sentinel errors for use with errors.Is(). Every Error returned by the SDK matches the sentinel
+// for its ErrorType, so callers can distinguish failure modes without inspecting status codes.
+//
+// Example Usage:
+//
+//	_, err := cli.GetStory(ctx, 1)
+//	if errors.Is(err, tines.ErrNotFound) {
+//		...
+//	}
+var (
+	ErrAuthentication = errors.New("tines: authentication failed")
+	ErrAuthorization  = errors.New("tines: not authorized")
+	ErrNotFound       = errors.New("tines: not found")
+	ErrConflict       = errors.New("tines: conflict")
+	ErrValidation     = errors.New("tines: validation failed")
+	ErrRateLimited    = errors.New("tines: rate limited")
+	ErrServer         = errors.New("tines: server error")
+)
+
+var errorTypeSentinels = map[ErrorType]error{
+	ErrorTypeAuthentication: ErrAuthentication,
+	ErrorTypeAuthorization:  ErrAuthorization,
+	ErrorTypeNotFound:       ErrNotFound,
+	ErrorTypeConflict:       ErrConflict,
+	ErrorTypeValidation:     ErrValidation,
+	ErrorTypeRateLimit:      ErrRateLimited,
+	ErrorTypeServer:         ErrServer,
+}
+
 type Error struct {
 	Type       ErrorType      `json:"type,omitempty"`
 	StatusCode int            `json:"status_code,omitempty"`
+	Method     string         `json:"method,omitempty"`
+	Path       string         `json:"path,omitempty"`
+	RequestID  string         `json:"request_id,omitempty"`
 	Errors     []ErrorMessage `json:"errors,omitempty"`
+	// The underlying error that caused the failure, if any (for example, a *url.Error from
+	// net/http or a *json.SyntaxError). It is exposed through Unwrap().
+	Err error `json:"-"`
 }
 
 type ErrorMessage struct {
@@ -52,11 +93,48 @@ func (e Error) Error() string {
 		}
 	}
 	errString = fmt.Sprintf("%d error(s) occurred: %s", errCount, strings.Join(errMessages, ", "))
+	if e.Method != "" {
+		errString = fmt.Sprintf("%s %s: %s", e.Method, e.Path, errString)
+	}
 	return errString
 }
 
+// Returns the underlying error, for compatibility with errors.As() and errors.Is().
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Reports whether the error matches one of the exported sentinel errors, for compatibility
+// with errors.Is().
+func (e Error) Is(target error) bool {
+	sentinel, ok := errorTypeSentinels[e.Type]
+	return ok && sentinel == target
+}
+
 // Check to see if an instantiated error object has more than zero `ErrorMessages` that have been
 // appended to it.
 func (e Error) HasErrors() bool {
 	return e.Errors != nil
 }
+
+// Map an HTTP error status code to the ErrorType that best describes it.
+func errorTypeForStatus(code int) ErrorType {
+	switch {
+	case code == http.StatusUnauthorized:
+		return ErrorTypeAuthentication
+	case code == http.StatusForbidden:
+		return ErrorTypeAuthorization
+	case code == http.StatusNotFound:
+		return ErrorTypeNotFound
+	case code == http.StatusConflict:
+		return ErrorTypeConflict
+	case code == http.StatusUnprocessableEntity:
+		return ErrorTypeValidation
+	case code == http.StatusTooManyRequests:
+		return ErrorTypeRateLimit
+	case code >= http.StatusInternalServerError:
+		return ErrorTypeServer
+	default:
+		return ErrorTypeRequest
+	}
+}
@@ -0,0 +1,149 @@
+package tines_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+func TestErrorTypes(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name     string
+		status   int
+		errType  tines.ErrorType
+		sentinel error
+	}{
+		{"BadRequest", http.StatusBadRequest, tines.ErrorTypeRequest, nil},
+		{"Unauthorized", http.StatusUnauthorized, tines.ErrorTypeAuthentication, tines.ErrAuthentication},
+		{"Forbidden", http.StatusForbidden, tines.ErrorTypeAuthorization, tines.ErrAuthorization},
+		{"NotFound", http.StatusNotFound, tines.ErrorTypeNotFound, tines.ErrNotFound},
+		{"Conflict", http.StatusConflict, tines.ErrorTypeConflict, tines.ErrConflict},
+		{"Unprocessable", http.StatusUnprocessableEntity, tines.ErrorTypeValidation, tines.ErrValidation},
+		{"TooManyRequests", http.StatusTooManyRequests, tines.ErrorTypeRateLimit, tines.ErrRateLimited},
+		{"ServerError", http.StatusBadGateway, tines.ErrorTypeServer, tines.ErrServer},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "abc123")
+				w.WriteHeader(test.status)
+				w.Write([]byte(`{"errors": [{"message": "error", "details": "details"}]}`)) //nolint:errcheck
+			}))
+			defer ts.Close()
+
+			cli, err := tines.NewClient(
+				tines.SetApiKey("foo"),
+				tines.SetTenantUrl(ts.URL),
+			)
+
+			assert.Nil(err, "the Tines CLI client should instantiate successfully")
+			if err != nil {
+				return
+			}
+
+			_, err = cli.GetStory(context.Background(), 1)
+
+			var tErr tines.Error
+			assert.ErrorAs(err, &tErr, "the error should be a Tines error")
+			assert.Equal(test.errType, tErr.Type, "the status code should map to the correct error type")
+			assert.Equal(test.status, tErr.StatusCode)
+			assert.Equal(http.MethodGet, tErr.Method, "the request method should be recorded")
+			assert.Equal("/api/v1/stories/1", tErr.Path, "the request path should be recorded")
+			assert.Equal("abc123", tErr.RequestID, "the request ID should be recorded")
+
+			if test.sentinel != nil {
+				assert.ErrorIs(err, test.sentinel, "the error should match its sentinel")
+			}
+			assert.False(errors.Is(err, tines.ErrConflict) && test.sentinel != tines.ErrConflict, "the error should not match other sentinels")
+		})
+	}
+}
+
+func TestErrorChainPreserved(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusServiceUnavailable, nil, []byte(`{"errors": [{"message": "unavailable", "details": "try again"}]}`))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.GetCredential(ctx, 1)
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "the error should be a Tines error")
+	assert.Equal(http.StatusServiceUnavailable, tErr.StatusCode, "the original status code should be preserved")
+	assert.Equal(tines.ErrorTypeServer, tErr.Type, "server errors should not be re-wrapped as request errors")
+	assert.Equal("unavailable", tErr.Errors[0].Message, "the original error messages should be preserved")
+
+	_, err = cli.UpdateResource(ctx, 1, &tines.Resource{Id: 1})
+	assert.ErrorIs(err, tines.ErrServer, "resource errors should keep their original type")
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(`{not json`))
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.GetCredential(ctx, 1)
+
+	var syntaxErr *json.SyntaxError
+	assert.ErrorAs(err, &syntaxErr, "JSON errors should be reachable through the error chain")
+
+	// Shut the server down so that the next request fails in transit.
+	ts.Close()
+
+	_, err = cli.GetCredential(ctx, 1)
+
+	var urlErr *url.Error
+	assert.ErrorAs(err, &urlErr, "net/http errors should be reachable through the error chain")
+}
+
+func TestErrorInvalidTenantUrl(t *testing.T) {
+	assert := assert.New(t)
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl("https://example.tines.com/%zz"),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetInfo(context.Background())
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "an unparseable tenant URL should return a Tines error")
+	assert.Equal(http.MethodGet, tErr.Method, "the error should include the request method")
+	assert.Equal("/api/v1/info", tErr.Path, "the error should include the request path")
+}
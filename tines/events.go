@@ -0,0 +1,102 @@
+package tines
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+// An Event emitted by an Action. The Payload is left as raw JSON because its structure
+// depends entirely on the Action that emitted it.
+type Event struct {
+	ID               int             `json:"id,omitempty"`
+	ActionID         int             `json:"agent_id,omitempty"`
+	StoryID          int             `json:"story_id,omitempty"`
+	StoryRunGuid     string          `json:"story_run_guid,omitempty"`
+	TeamID           int             `json:"team_id,omitempty"`
+	UserID           int             `json:"user_id,omitempty"`
+	PreviousEventIDs []int           `json:"previous_events_ids,omitempty"`
+	Payload          json.RawMessage `json:"payload,omitempty"`
+	CreatedAt        Time            `json:"created_at,omitzero"`
+	UpdatedAt        Time            `json:"updated_at,omitzero"`
+}
+
+type EventList struct {
+	Events []Event       `json:"events,omitempty"`
+	Meta   paginate.Meta `json:"meta,omitempty"`
+}
+
+// Get a single Event by unique ID.
+func (c *Client) GetEvent(ctx context.Context, id int) (*Event, error) {
+	ctx = withOperation(ctx, "GetEvent")
+	resource := fmt.Sprintf("/api/v1/events/%d", id)
+	event := Event{}
+
+	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// Yields an iterator that returns individual Events, optionally filtered by Story ID, Action ID,
+// Team ID, and/or creation timestamp. If no other filters are specified, ListEvents() will
+// recurse through all pages of results until no more are available. If
+// `filters.WithMaxResults()` is set, this function will yield either the actual set of results
+// or the specified maximum number of results, whichever is less.
+//
+// Example Usage:
+//
+//	lf := NewListFilter(WithActionId(1), WithResultsAfter("2025-01-01"))
+//	for e, err := range ListEvents(ctx, lf) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(string(e.Payload))
+//	}
+func (c *Client) ListEvents(ctx context.Context, f ListFilter) iter.Seq2[Event, error] {
+	ctx = withOperation(ctx, "ListEvents")
+	resource := "/api/v1/events"
+
+	return newList[Event](c, resource, "events", f).All(ctx)
+}
+
+// Re-emit an existing Event, causing any Actions that receive from the emitting Action to run
+// again with the same payload. The newly emitted Event is returned.
+func (c *Client) ReEmitEvent(ctx context.Context, id int) (*Event, error) {
+	ctx = withOperation(ctx, "ReEmitEvent")
+	resource := fmt.Sprintf("/api/v1/events/%d/reemit", id)
+	event := Event{}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// Delete a single Event by unique ID.
+func (c *Client) DeleteEvent(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteEvent")
+	resource := fmt.Sprintf("/api/v1/events/%d", id)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
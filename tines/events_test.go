@@ -0,0 +1,171 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testEventResp = `
+{
+    "id": 1,
+    "agent_id": 1,
+    "story_id": 1,
+    "story_run_guid": "b2f3e1d4c5a6978812345678abcdef01",
+    "team_id": 1,
+    "user_id": 1,
+    "previous_events_ids": [],
+    "payload": {
+        "body": {
+            "foo": "bar"
+        }
+    },
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:00:00Z"
+}`
+	// Hand-written example API response.
+	testListEventsResp = `
+{
+    "events": [
+        {
+            "id": 1,
+            "agent_id": 1,
+            "story_id": 1,
+            "story_run_guid": "b2f3e1d4c5a6978812345678abcdef01",
+            "team_id": 1,
+            "user_id": 1,
+            "previous_events_ids": [],
+            "payload": {
+                "body": {
+                    "foo": "bar"
+                }
+            },
+            "created_at": "2025-06-02T00:00:00Z",
+            "updated_at": "2025-06-02T00:00:00Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/events?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+)
+
+func TestGetEvent(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/events/1", http.StatusOK, nil, []byte(testEventResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	e, err := cli.GetEvent(ctx, 1)
+
+	assert.Nil(err, "the Tines client should retrieve an event successfully")
+	assert.Equal(1, e.ActionID, "the event action ID should be parsed")
+	assert.JSONEq(`{"body": {"foo": "bar"}}`, string(e.Payload), "the event payload should be preserved as raw JSON")
+}
+
+func TestListEvents(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodGet, r.Method, "client should use the expected HTTP method")
+		assert.Equal("/api/v1/events", r.URL.Path, "client should call the expected endpoint")
+		q := r.URL.Query()
+		assert.Equal("1", q.Get("story_id"), "the story filter should be sent")
+		assert.Equal("2", q.Get("agent_id"), "the action filter should be sent")
+		assert.Equal("3", q.Get("team_id"), "the team filter should be sent")
+		assert.Equal("2025-06-01T00:00:00Z", q.Get("after"), "the after filter should be sent")
+		w.Write([]byte(testListEventsResp)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	lf := tines.NewListFilter(
+		tines.WithStoryId(1),
+		tines.WithActionId(2),
+		tines.WithTeamId(3),
+		tines.WithResultsAfter("2025-06-01"),
+	)
+
+	for e, err := range cli.ListEvents(ctx, lf) {
+		assert.Nil(err, "the list of events should be iterable")
+		assert.Equal(1, e.ID, "the event ID should be retrieved successfully")
+	}
+}
+
+func TestReEmitEvent(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/events/1/reemit", http.StatusOK, nil, []byte(testEventResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	e, err := cli.ReEmitEvent(ctx, 1)
+
+	assert.Nil(err, "the Tines client should re-emit an event successfully")
+	assert.Equal(1, e.ID, "the re-emitted event should be parsed")
+}
+
+func TestDeleteEvent(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/events/1", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.DeleteEvent(ctx, 1)
+
+	assert.Nil(err, "the Tines client should delete the event successfully")
+}
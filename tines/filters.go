@@ -43,13 +43,25 @@ const (
 	OrderByRecentlyEditedDesc StoryOrder = "RECENTLY_EDITED"
 )
 
+// Enum for filtering a List of Action logs by severity.
+type LogLevel int
+
+const (
+	LogLevelWarning LogLevel = 2
+	LogLevelInfo    LogLevel = 3
+	LogLevelError   LogLevel = 4
+)
+
 type ListFilter struct {
 	TeamID       int          `json:"team_id,omitempty"`
+	StoryID      int          `json:"story_id,omitempty"`
+	ActionID     int          `json:"agent_id,omitempty"`
 	FolderID     int          `json:"folder_id,omitempty"`
 	ContentType  string       `json:"content_type,omitempty"`
 	Before       string       `json:"before,omitempty"`
 	After        string       `json:"after,omitempty"`
 	UserID       int          `json:"user_id,omitempty"`
+	LogLevel     LogLevel     `json:"level,omitempty"`
 	OpName       string       `json:"operation_name,omitempty"`
 	ResultFilter ResultFilter `json:"filter,omitempty"`
 	StoryOrder   StoryOrder   `json:"order,omitempty"`
@@ -57,6 +69,9 @@ type ListFilter struct {
 	PerPage      int          `json:"per_page,omitempty"`
 	Page         int          `json:"page,omitempty"`
 	maxResults   int
+	prefetch     int
+	cursor       *ListCursor
+	pageStart    func(n int)
 }
 
 // Filter results returned by a List endpoint (eg List Credentials, List Stories, etc).
@@ -87,6 +102,24 @@ func WithTeamId(id int) func(*ListFilter) {
 	}
 }
 
+// Limit results returned by a List endpoint to only the results that belong to a particular Story ID.
+func WithStoryId(id int) func(*ListFilter) {
+	return func(lf *ListFilter) {
+		if id > 0 {
+			lf.StoryID = id
+		}
+	}
+}
+
+// Limit results returned by a List endpoint to only the results that belong to a particular Action ID.
+func WithActionId(id int) func(*ListFilter) {
+	return func(lf *ListFilter) {
+		if id > 0 {
+			lf.ActionID = id
+		}
+	}
+}
+
 // Limit results returned by a List endpoint to only the results that belong to a particular User ID.
 func WithUserId(id int) func(*ListFilter) {
 	return func(lf *ListFilter) {
@@ -96,6 +129,13 @@ func WithUserId(id int) func(*ListFilter) {
 	}
 }
 
+// Limit results returned by the List Action Logs endpoint to only logs of a particular severity.
+func WithLogLevel(l LogLevel) func(*ListFilter) {
+	return func(lf *ListFilter) {
+		lf.LogLevel = l
+	}
+}
+
 // Limit results returned by the List Folders endpoint to only folders that contain a certain type of content
 // (eg Credentials, Resources, or Stories).
 func WithContentType(ct string) func(*ListFilter) {
@@ -194,6 +234,25 @@ func WithMaxResults(i int) func(*ListFilter) {
 	}
 }
 
+// Fetch the remaining pages of results concurrently, with up to `workers` requests in flight,
+// once the first page has reported the total number of pages. Results are still yielded in
+// order, and every request still waits on the client's RateLimiter. Values below 2 fetch pages
+// one at a time, which is the default.
+func WithPagePrefetch(workers int) func(*ListFilter) {
+	return func(lf *ListFilter) {
+		lf.prefetch = workers
+	}
+}
+
+// Resume a List iteration from the position held by `c`, and keep `c` up to date as results
+// are yielded. Use a new ListCursor, or one restored with ParseListCursor(), for each listing.
+// When resuming, WithMaxResults() limits the number of results returned after the resume point.
+func WithListCursor(c *ListCursor) func(*ListFilter) {
+	return func(lf *ListFilter) {
+		lf.cursor = c
+	}
+}
+
 func (l *ListFilter) AppendFilter(opt func(*ListFilter)) {
 	opt(l)
 }
@@ -25,6 +25,7 @@ type FolderList struct {
 
 // Create a new Folder. Name, TeamID, and ContentType are required parameters.
 func (c *Client) CreateFolder(ctx context.Context, f *Folder) (*Folder, error) {
+	ctx = withOperation(ctx, "CreateFolder")
 	resource := "/api/v1/folders"
 	errs := Error{Type: ErrorTypeRequest}
 
@@ -69,6 +70,7 @@ func (c *Client) CreateFolder(ctx context.Context, f *Folder) (*Folder, error) {
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -87,6 +89,7 @@ func (c *Client) CreateFolder(ctx context.Context, f *Folder) (*Folder, error) {
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -95,6 +98,7 @@ func (c *Client) CreateFolder(ctx context.Context, f *Folder) (*Folder, error) {
 
 // Get a Folder by unique ID.
 func (c *Client) GetFolder(ctx context.Context, id int) (*Folder, error) {
+	ctx = withOperation(ctx, "GetFolder")
 	f := Folder{}
 	resource := fmt.Sprintf("/api/v1/folders/%d", id)
 
@@ -114,6 +118,7 @@ func (c *Client) GetFolder(ctx context.Context, id int) (*Folder, error) {
 // Update a Folder by unique ID. The only attribute that can be updated is the folder name.
 // To change any other folder attribute, the resource must be deleted and recreated.
 func (c *Client) UpdateFolder(ctx context.Context, id int, name string) (*Folder, error) {
+	ctx = withOperation(ctx, "UpdateFolder")
 	f := Folder{}
 	resource := fmt.Sprintf("/api/v1/folders/%d", id)
 	var params = make(map[string]any)
@@ -147,61 +152,15 @@ func (c *Client) UpdateFolder(ctx context.Context, id int, name string) (*Folder
 //		fmt.Println(f.Name)
 //	}
 func (c *Client) ListFolders(ctx context.Context, f ListFilter) iter.Seq2[Folder, error] {
-	var folderList, resultList FolderList
+	ctx = withOperation(ctx, "ListFolders")
 	resource := "/api/v1/folders"
-	params := f.ToParamMap()
-	page := paginate.Cursor{
-		TotalRequested: f.MaxResults(),
-	}
-
-	c.logger.Debug(fmt.Sprintf("max results requested: %d", page.TotalRequested))
-
-	return func(yield func(Folder, error) bool) {
-
-		for !page.MaxResultsReturned() {
-			res, err := c.doRequest(ctx, http.MethodGet, resource, params, nil)
-			if err != nil {
-				yield(Folder{}, err)
-				return
-			}
-
-			err = json.Unmarshal(res, &resultList)
-			if err != nil {
-				yield(Folder{}, err)
-				return
-			}
-
-			page.UpdatePagination(resultList.Meta)
-			params = page.GetNextPageParams()
-
-			for _, v := range resultList.Folders {
-				folderList.Folders = append(folderList.Folders, v)
-				page.IncrementCounter()
-				if page.MaxResultsReturned() {
-					c.logger.Debug("hit the limit of results to return")
-					break
-				}
-			}
-
-			// Clear the temporary result buffer
-			resultList = FolderList{}
-
-			if !page.ReturnMoreResults() {
-				c.logger.Debug("no more results to return")
-				break
-			}
-		}
 
-		for _, v := range folderList.Folders {
-			if !yield(v, nil) {
-				return
-			}
-		}
-	}
+	return newList[Folder](c, resource, "folders", f).All(ctx)
 }
 
 // Delete a folder by unique ID.
 func (c *Client) DeleteFolder(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteFolder")
 	resource := fmt.Sprintf("/api/v1/folders/%d", id)
 
 	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
@@ -25,6 +25,7 @@ type WorkerStats struct {
 }
 
 func (c *Client) GetInfo(ctx context.Context) (*TenantInfo, error) {
+	ctx = withOperation(ctx, "GetInfo")
 	t := TenantInfo{}
 	resource := "/api/v1/info"
 
@@ -42,6 +43,7 @@ func (c *Client) GetInfo(ctx context.Context) (*TenantInfo, error) {
 }
 
 func (c *Client) GetWorkerStats(ctx context.Context) (*WorkerStats, error) {
+	ctx = withOperation(ctx, "GetWorkerStats")
 	w := WorkerStats{}
 	resource := "/api/v1/info/worker_stats"
 
@@ -0,0 +1,74 @@
+package tines_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSetSlogLogger(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testListStoriesResp))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetSlogLogger(logger),
+		tines.SetWireLogging(true),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	for _, err := range cli.ListStories(context.Background(), tines.NewListFilter()) {
+		assert.Nil(err)
+	}
+
+	out := buf.String()
+	assert.Contains(out, `"level":"DEBUG"`, "the SDK should log at a debug level")
+	assert.Contains(out, `"msg":"sending request"`, "request messages should be routed to the slog logger")
+	assert.Contains(out, `"msg":"tines api request"`, "wire logs should be routed to the slog logger")
+	assert.Contains(out, `"status":200`, "structured fields should be preserved")
+	assert.Contains(out, "max results requested", "list iterator messages should be routed to the slog logger")
+}
+
+func TestSetLoggerZap(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetLogger(zap.New(core)),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+	assert.Nil(err)
+
+	entries := logs.FilterMessage("sending request").All()
+	if assert.Len(entries, 1, "request messages should be routed to the zap logger") {
+		assert.Equal(http.MethodGet, entries[0].ContextMap()["method"])
+	}
+}
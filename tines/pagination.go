@@ -0,0 +1,241 @@
+package tines
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"sync"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+// Build a paginated list for the List endpoint at `resource`, whose results are returned
+// under the JSON field `key`.
+func newList[T any](c *Client, resource string, key string, f ListFilter) paginate.List[T] {
+	c.logger.Debug(fmt.Sprintf("max results requested: %d", f.MaxResults()))
+
+	l := paginate.List[T]{
+		Fetch: func(ctx context.Context, params map[string]any) ([]byte, error) {
+			page := pageNumber(params)
+			ctx = withPage(ctx, page)
+
+			body, err := c.doRequest(ctx, http.MethodGet, resource, params, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			c.recordPage(ctx, page)
+			return body, nil
+		},
+		Extract:    paginate.Field[T](key),
+		Params:     f.ToParamMap(),
+		MaxResults: f.MaxResults(),
+		Prefetch:   f.prefetch,
+		Debug: func(msg string) {
+			c.logger.Debug(msg)
+		},
+	}
+
+	if f.cursor != nil {
+		l.Resume = f.cursor.position
+		l.Track = f.cursor.update
+	}
+
+	l.PageStart = f.pageStart
+
+	return l
+}
+
+// Collect every result yielded by one of the List iterators into a slice. If the iterator
+// yields an error, the results collected so far are returned alongside it.
+//
+// Example Usage:
+//
+//	stories, err := tines.Collect(cli.ListStories(ctx, tines.NewListFilter()))
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var results []T
+
+	for v, err := range seq {
+		if err != nil {
+			return results, err
+		}
+		results = append(results, v)
+	}
+
+	return results, nil
+}
+
+// Yields the results of one of the List functions a page at a time, as returned by the API, for
+// callers that process results in batches. Each page is requested only once the previous page
+// has been consumed, and WithMaxResults() trims the final page. `list` must pass the ListFilter
+// it is given on to a List function; if it does not, an error is yielded instead of results.
+//
+// Example Usage:
+//
+//	for stories, err := range tines.Pages(ctx, cli.ListStories, tines.NewListFilter()) {
+//		if err != nil {
+//			...
+//		}
+//		process(stories)
+//	}
+func Pages[T any](ctx context.Context, list func(context.Context, ListFilter) iter.Seq2[T, error], f ListFilter) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		var page []T
+		remaining := 0
+		started := false
+
+		f.pageStart = func(n int) {
+			page = make([]T, 0, n)
+			remaining = n
+			started = true
+		}
+
+		for v, err := range list(ctx, f) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			// Without the page hook, page boundaries are unknown and the only option would be to
+			// buffer the whole listing.
+			if !started {
+				yield(nil, Error{
+					Type: ErrorTypeRequest,
+					Errors: []ErrorMessage{
+						{
+							Message: errParseError,
+							Details: "the list function passed to Pages must pass on the ListFilter it is given",
+						},
+					},
+				})
+				return
+			}
+
+			page = append(page, v)
+			remaining--
+
+			if remaining == 0 {
+				if !yield(page, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// A bookmark in a List iteration that can be serialized and restored later, so that a long
+// listing interrupted part-way through can pick up where it stopped instead of starting over.
+// Pass it to a List function with WithListCursor(): iteration starts from the position the
+// cursor holds, and the cursor is advanced past each result just before it is yielded. A
+// ListCursor is safe for concurrent use.
+//
+// Example Usage:
+//
+//	cur, err := tines.ParseListCursor(savedToken)
+//	if err != nil {
+//		...
+//	}
+//
+//	for l, err := range cli.ListAuditLogs(ctx, tines.NewListFilter(tines.WithListCursor(cur))) {
+//		if err != nil {
+//			...
+//		}
+//		process(l)
+//		savedToken = cur.Token()
+//	}
+type ListCursor struct {
+	mu  sync.Mutex
+	pos *paginate.Position
+}
+
+// Restore a ListCursor from a token previously returned by ListCursor.Token(). An empty token
+// returns a cursor that starts from the beginning of the listing.
+func ParseListCursor(token string) (*ListCursor, error) {
+	c := &ListCursor{}
+
+	err := c.UnmarshalText([]byte(token))
+	if err != nil {
+		return nil, Error{
+			Type: ErrorTypeRequest,
+			Errors: []ErrorMessage{
+				{
+					Message: errParseError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+
+	return c, nil
+}
+
+// Returns an opaque, URL-safe token for the current position of the cursor, or an empty string
+// if iteration has not started yet.
+func (c *ListCursor) Token() string {
+	text, _ := c.MarshalText()
+	return string(text)
+}
+
+// Implements encoding.TextMarshaler, so that a ListCursor can be stored directly in JSON or YAML
+// job state.
+func (c *ListCursor) MarshalText() ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pos == nil {
+		return []byte{}, nil
+	}
+
+	data, err := json.Marshal(c.pos)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(base64.RawURLEncoding.EncodeToString(data)), nil
+}
+
+// Implements encoding.TextUnmarshaler.
+func (c *ListCursor) UnmarshalText(text []byte) error {
+	var pos *paginate.Position
+
+	if len(text) > 0 {
+		data, err := base64.RawURLEncoding.DecodeString(string(text))
+		if err != nil {
+			return fmt.Errorf("invalid list cursor token: %w", err)
+		}
+
+		pos = &paginate.Position{}
+		err = json.Unmarshal(data, pos)
+		if err != nil {
+			return fmt.Errorf("invalid list cursor token: %w", err)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pos = pos
+
+	return nil
+}
+
+func (c *ListCursor) position() (paginate.Position, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.pos == nil {
+		return paginate.Position{}, false
+	}
+
+	return *c.pos, true
+}
+
+func (c *ListCursor) update(pos paginate.Position) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pos = &pos
+}
@@ -0,0 +1,174 @@
+package tines
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// A token bucket rate limiter that every API request made by a Client waits on before it is
+// sent. A single RateLimiter is safe for concurrent use and can be shared by several Clients
+// that point at the same tenant, so that together they stay under the tenant's API limit.
+//
+// When the Tines API returns X-RateLimit-Remaining and X-RateLimit-Reset headers, the limiter
+// adjusts to them automatically: it never allows more requests than the server reports as
+// remaining, and pauses all requests until the reset time once the server-side limit is used up.
+type RateLimiter struct {
+	mu           sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// Create a new RateLimiter that allows an average of `requestsPerSecond` requests, with bursts
+// of up to `burst` requests at a time. A `requestsPerSecond` of zero or less applies no
+// client-side limit, so the limiter only pauses requests when the server reports that its own
+// limit has been used up.
+//
+// Example Usage:
+//
+//	limiter := tines.NewRateLimiter(5, 10)
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetRateLimiter(limiter),
+//	)
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// You may optionally pass a RateLimiter when creating a new client. The same RateLimiter can
+// be passed to multiple clients to share a single request budget between them.
+func SetRateLimiter(l *RateLimiter) func(*Client) {
+	return func(c *Client) {
+		c.rateLimiter = l
+	}
+}
+
+// Block until a request is allowed to proceed, or return an error if the context is cancelled
+// or its deadline would pass before a request is allowed. A nil RateLimiter never blocks.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	wait := l.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		l.cancel()
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Take a token from the bucket, returning how long the caller must wait before using it. The
+// bucket is allowed to go negative so that concurrent callers queue up behind each other.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	var wait time.Duration
+	if l.rate > 0 {
+		l.refill(now)
+		l.tokens--
+
+		if l.tokens < 0 {
+			wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+		}
+	}
+
+	if l.blockedUntil.After(now) {
+		wait = max(wait, l.blockedUntil.Sub(now))
+	}
+
+	return wait
+}
+
+// Return an unused token to the bucket.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = min(l.tokens+1, l.burst)
+}
+
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	l.last = now
+	if elapsed > 0 {
+		l.tokens = min(l.tokens+elapsed*l.rate, l.burst)
+	}
+}
+
+// Adjust the limiter to the rate limit state reported by the server.
+func (l *RateLimiter) update(h http.Header) {
+	if l == nil {
+		return
+	}
+
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.tokens = min(l.tokens, float64(remaining))
+
+	if remaining <= 0 {
+		if reset, ok := parseRateLimitReset(h.Get("X-RateLimit-Reset")); ok && reset.After(l.blockedUntil) {
+			l.blockedUntil = reset
+		}
+	}
+}
+
+// The X-RateLimit-Reset header may either be a Unix timestamp or a number of seconds until
+// the limit resets.
+func parseRateLimitReset(v string) (time.Time, bool) {
+	s, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || s < 0 {
+		return time.Time{}, false
+	}
+
+	// Anything that looks like a timestamp after 2001 is treated as absolute.
+	if s > 1_000_000_000 {
+		return time.Unix(s, 0), true
+	}
+
+	return time.Now().Add(time.Duration(s) * time.Second), true
+}
@@ -0,0 +1,174 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+func TestRateLimiterSharedBetweenClients(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testGetInfoResp))
+	defer ts.Close()
+
+	limiter := tines.NewRateLimiter(20, 1)
+
+	var clients []*tines.Client
+	for range 2 {
+		cli, err := tines.NewClient(
+			tines.SetApiKey("foo"),
+			tines.SetTenantUrl(ts.URL),
+			tines.SetRateLimiter(limiter),
+		)
+		assert.Nil(err, "the Tines CLI client should instantiate successfully")
+		if err != nil {
+			return
+		}
+		clients = append(clients, cli)
+	}
+
+	ctx := context.Background()
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for _, cli := range clients {
+		for range 2 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := cli.GetInfo(ctx)
+				assert.Nil(err, "rate limited requests should still succeed")
+			}()
+		}
+	}
+	wg.Wait()
+
+	// One request is allowed immediately, and the other three are spaced 50ms apart.
+	assert.GreaterOrEqual(time.Since(start), 140*time.Millisecond, "requests from every client sharing the limiter should be throttled together")
+}
+
+func TestRateLimiterRespectsDeadline(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testGetInfoResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRateLimiter(tines.NewRateLimiter(0.1, 1)),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetInfo(context.Background())
+	assert.Nil(err, "the first request should use the burst allowance")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = cli.GetInfo(ctx)
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "the rate limiter should fail fast when the deadline is too short")
+	assert.Equal(tines.ErrorTypeRateLimit, tErr.Type)
+	assert.Less(time.Since(start), 50*time.Millisecond, "the request should not wait for a deadline it cannot meet")
+}
+
+func TestRateLimiterCancelled(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testGetInfoResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRateLimiter(tines.NewRateLimiter(0.1, 1)),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetInfo(context.Background())
+	assert.Nil(err, "the first request should use the burst allowance")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err = cli.GetInfo(ctx)
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "the request should fail with a Tines error when cancelled while rate limited")
+	assert.Equal(tines.ErrorTypeRequest, tErr.Type, "a cancelled request should not be reported as rate limited")
+	assert.ErrorIs(err, context.Canceled, "the cancellation should be preserved in the error chain")
+}
+
+func TestRateLimiterAdaptsToHeaders(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "60")
+		w.Write([]byte(testGetInfoResp)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRateLimiter(tines.NewRateLimiter(100, 100)),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetInfo(context.Background())
+	assert.Nil(err, "the first request should succeed")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, err = cli.GetInfo(ctx)
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "requests should be held until the server-side limit resets")
+	assert.Equal(tines.ErrorTypeRateLimit, tErr.Type)
+}
+
+func TestRateLimiterWithoutRate(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testGetInfoResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRateLimiter(tines.NewRateLimiter(0, 1)),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	for range 5 {
+		_, err = cli.GetInfo(ctx)
+		assert.Nil(err, "a limiter without a rate should not hold requests once the burst is used up")
+	}
+}
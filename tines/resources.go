@@ -25,8 +25,8 @@ type Resource struct {
 	TestResource   any      `json:"test_resource,omitempty"`
 	IsTest         bool     `json:"is_test,omitempty"`
 	LiveResourceId int      `json:"live_resource_id,omitempty"`
-	CreatedAt      string   `json:"created_at,omitempty"`
-	UpdatedAt      string   `json:"updated_at,omitempty"`
+	CreatedAt      Time     `json:"created_at,omitzero"`
+	UpdatedAt      Time     `json:"updated_at,omitzero"`
 	RefActions     []int    `json:"referencing_action_ids,omitempty"`
 }
 
@@ -44,6 +44,7 @@ type ResourceList struct {
 }
 
 func (c *Client) CreateResource(ctx context.Context, r *Resource) (*Resource, error) {
+	ctx = withOperation(ctx, "CreateResource")
 	resource := "/api/v1/global_resources"
 	newRes := Resource{}
 
@@ -57,6 +58,7 @@ func (c *Client) CreateResource(ctx context.Context, r *Resource) (*Resource, er
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -75,6 +77,7 @@ func (c *Client) CreateResource(ctx context.Context, r *Resource) (*Resource, er
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -82,20 +85,13 @@ func (c *Client) CreateResource(ctx context.Context, r *Resource) (*Resource, er
 }
 
 func (c *Client) GetResource(ctx context.Context, id int) (*Resource, error) {
+	ctx = withOperation(ctx, "GetResource")
 	resource := fmt.Sprintf("/api/v1/global_resources/%d", id)
 	res := Resource{}
 
 	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
 	if err != nil {
-		return &res, Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return &res, err
 	}
 
 	err = json.Unmarshal(body, &res)
@@ -108,6 +104,7 @@ func (c *Client) GetResource(ctx context.Context, id int) (*Resource, error) {
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -115,6 +112,7 @@ func (c *Client) GetResource(ctx context.Context, id int) (*Resource, error) {
 }
 
 func (c *Client) UpdateResource(ctx context.Context, id int, r *Resource) (*Resource, error) {
+	ctx = withOperation(ctx, "UpdateResource")
 	resource := fmt.Sprintf("/api/v1/global_resources/%d", id)
 	errs := Error{Type: ErrorTypeRequest}
 	updatedRes := Resource{}
@@ -140,20 +138,13 @@ func (c *Client) UpdateResource(ctx context.Context, id int, r *Resource) (*Reso
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
 	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, req)
 	if err != nil {
-		return &updatedRes, Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return &updatedRes, err
 	}
 
 	err = json.Unmarshal(body, &updatedRes)
@@ -166,6 +157,7 @@ func (c *Client) UpdateResource(ctx context.Context, id int, r *Resource) (*Reso
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
@@ -174,57 +166,14 @@ func (c *Client) UpdateResource(ctx context.Context, id int, r *Resource) (*Reso
 }
 
 func (c *Client) ListResources(ctx context.Context, f ListFilter) iter.Seq2[Resource, error] {
-	var resourceList, resultList ResourceList
+	ctx = withOperation(ctx, "ListResources")
 	resource := "/api/v1/global_resources"
-	params := f.ToParamMap()
-	page := paginate.Cursor{
-		TotalRequested: f.MaxResults(),
-	}
-	return func(yield func(Resource, error) bool) {
-
-		for !page.MaxResultsReturned() {
-			res, err := c.doRequest(ctx, http.MethodGet, resource, params, nil)
-			if err != nil {
-				yield(Resource{}, err)
-				return
-			}
-
-			err = json.Unmarshal(res, &resultList)
-			if err != nil {
-				yield(Resource{}, err)
-				return
-			}
-
-			page.UpdatePagination(resultList.Meta)
-			params = page.GetNextPageParams()
-
-			for _, v := range resultList.GlobalResources {
-				resourceList.GlobalResources = append(resourceList.GlobalResources, v)
-				page.IncrementCounter()
-				if page.MaxResultsReturned() {
-					c.logger.Debug("hit the limit of results to return")
-					break
-				}
-			}
-
-			// Clear the temporary result buffer
-			resultList = ResourceList{}
-
-			if !page.ReturnMoreResults() {
-				c.logger.Debug("no more results to return")
-				break
-			}
-		}
 
-		for _, v := range resourceList.GlobalResources {
-			if !yield(v, nil) {
-				return
-			}
-		}
-	}
+	return newList[Resource](c, resource, "global_resources", f).All(ctx)
 }
 
 func (c *Client) DeleteResource(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteResource")
 	resource := fmt.Sprintf("/api/v1/global_resources/%d", id)
 
 	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
@@ -241,6 +190,7 @@ func (c *Client) DeleteResource(ctx context.Context, id int) error {
 // array if the underlying Resource is an array. To make the results consistent with the stringified
 // Resource value returned by GetResource(), we automatically stringify array results.
 func (c *Client) AppendResourceElement(ctx context.Context, id int, e *ResourceElement) (string, error) {
+	ctx = withOperation(ctx, "AppendResourceElement")
 	resource := fmt.Sprintf("/api/v1/global_resources/%d/append", id)
 
 	req, err := json.Marshal(e)
@@ -253,20 +203,13 @@ func (c *Client) AppendResourceElement(ctx context.Context, id int, e *ResourceE
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
 	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
 	if err != nil {
-		return "", Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return "", err
 	}
 
 	return string(body), nil
@@ -276,6 +219,7 @@ func (c *Client) AppendResourceElement(ctx context.Context, id int, e *ResourceE
 // or by index (if the resource is an array). Currently, the result of this API operation is returned as a string,
 // which is not consistent with the results of the API operations for appending or replacing Resource elements.
 func (c *Client) RemoveResourceElement(ctx context.Context, id int, e *ResourceElement) (string, error) {
+	ctx = withOperation(ctx, "RemoveResourceElement")
 	resource := fmt.Sprintf("/api/v1/global_resources/%d/remove", id)
 	errs := Error{Type: ErrorTypeRequest}
 
@@ -300,26 +244,20 @@ func (c *Client) RemoveResourceElement(ctx context.Context, id int, e *ResourceE
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
 	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
 	if err != nil {
-		return "", Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return "", err
 	}
 
 	return string(body), nil
 }
 
 func (c *Client) ReplaceResourceElement(ctx context.Context, id int, e *ResourceElement) (*ResourceElement, error) {
+	ctx = withOperation(ctx, "ReplaceResourceElement")
 	resource := fmt.Sprintf("/api/v1/global_resources/%d/replace", id)
 	errs := Error{Type: ErrorTypeRequest}
 	updatedRes := ResourceElement{}
@@ -345,20 +283,13 @@ func (c *Client) ReplaceResourceElement(ctx context.Context, id int, e *Resource
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
 	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
 	if err != nil {
-		return &updatedRes, Error{
-			Type: ErrorTypeRequest,
-			Errors: []ErrorMessage{
-				{
-					Message: errDoRequestError,
-					Details: err.Error(),
-				},
-			},
-		}
+		return &updatedRes, err
 	}
 
 	err = json.Unmarshal(body, &updatedRes)
@@ -371,6 +302,7 @@ func (c *Client) ReplaceResourceElement(ctx context.Context, id int, e *Resource
 					Details: err.Error(),
 				},
 			},
+			Err: err,
 		}
 	}
 
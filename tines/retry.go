@@ -0,0 +1,131 @@
+package tines
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 30 * time.Second
+)
+
+// Controls how the client retries failed API requests. A zero-value RetryPolicy makes exactly
+// one attempt per request, which is the default behavior when no policy is set.
+type RetryPolicy struct {
+	// The total number of attempts made for a single request, including the first one.
+	MaxAttempts int
+	// The base delay before the first retry. Each subsequent retry doubles the delay, with
+	// random jitter applied. Defaults to 500ms.
+	MinBackoff time.Duration
+	// The upper bound for the computed delay between retries. A Retry-After header sent by
+	// the server always takes precedence. Defaults to 30s.
+	MaxBackoff time.Duration
+	// Decides whether a failed request should be retried. The status code is 0 if no response
+	// was received. Defaults to DefaultShouldRetry.
+	ShouldRetry func(method string, statusCode int, err error) bool
+}
+
+// You may optionally configure the client to retry failed API requests with exponential
+// backoff. Only idempotent requests that fail with a transient error are retried unless a
+// custom ShouldRetry function is provided.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetRetryPolicy(tines.RetryPolicy{MaxAttempts: 5}),
+//	)
+func SetRetryPolicy(p RetryPolicy) func(*Client) {
+	return func(c *Client) {
+		if p.MinBackoff <= 0 {
+			p.MinBackoff = defaultRetryMinBackoff
+		}
+		if p.MaxBackoff <= 0 {
+			p.MaxBackoff = defaultRetryMaxBackoff
+		}
+		if p.MaxBackoff < p.MinBackoff {
+			p.MaxBackoff = p.MinBackoff
+		}
+		if p.ShouldRetry == nil {
+			p.ShouldRetry = DefaultShouldRetry
+		}
+		c.retryPolicy = p
+	}
+}
+
+// The default retry decision: idempotent requests (GET, HEAD, OPTIONS, PUT, and DELETE) are
+// retried when no response was received, or when the server responds with a 429, 502, 503,
+// or 504 status code.
+func DefaultShouldRetry(method string, statusCode int, err error) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	// Anything below 400 that still produced an error failed in transit, either before a
+	// response was received or while reading the body.
+	return err != nil && statusCode < http.StatusBadRequest
+}
+
+func (p RetryPolicy) retryable(ctx context.Context, attempt int, method string, resp *http.Response, err error) bool {
+	if attempt >= p.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+
+	return p.ShouldRetry(method, statusCode, err)
+}
+
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return d
+		}
+	}
+
+	// Compare before shifting, so that a large MinBackoff or attempt count can't overflow.
+	d := p.MaxBackoff
+	if shift := attempt - 1; shift < 63 && p.MinBackoff <= p.MaxBackoff>>shift {
+		d = p.MinBackoff << shift
+	}
+
+	// Equal jitter: keep half of the delay and randomize the other half, so that clients
+	// which failed together don't all retry at the same instant.
+	half := d / 2
+	return half + time.Duration(rand.Int64N(int64(half)+1))
+}
+
+// The Retry-After header may either be a number of seconds or an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0, false
+		}
+		return time.Duration(s) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		return max(time.Until(t), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,19 @@
+package tines
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBackoffLarge(t *testing.T) {
+	assert := assert.New(t)
+	p := RetryPolicy{MaxAttempts: 100, MinBackoff: time.Hour, MaxBackoff: 1000 * time.Hour}
+
+	for attempt := 1; attempt <= p.MaxAttempts; attempt++ {
+		d := p.backoff(attempt, nil)
+		assert.GreaterOrEqual(d, p.MinBackoff/2, "the backoff should never drop below half of MinBackoff")
+		assert.LessOrEqual(d, p.MaxBackoff, "the backoff should never exceed MaxBackoff")
+	}
+}
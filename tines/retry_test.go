@@ -0,0 +1,186 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+// Returns a test server that fails with the given status code until the specified number of
+// failures has been served, then responds successfully.
+func createFlakyTestServer(failures int32, status int, retryAfter string, calls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(testGetInfoResp)) //nolint:errcheck
+	}))
+}
+
+func TestRetrySuccess(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createFlakyTestServer(2, http.StatusServiceUnavailable, "", &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRetryPolicy(tines.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	info, err := cli.GetInfo(context.Background())
+
+	assert.Nil(err, "the request should succeed after retrying")
+	assert.Equal("us1", info.Stack.Name, "the response from the successful attempt should be returned")
+	assert.Equal(int32(3), calls.Load(), "the request should be attempted until it succeeds")
+}
+
+func TestRetryExhausted(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createFlakyTestServer(5, http.StatusTooManyRequests, "0", &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRetryPolicy(tines.RetryPolicy{MaxAttempts: 2}),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetInfo(context.Background())
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "the last error should be returned once retries are exhausted")
+	assert.Equal(http.StatusTooManyRequests, tErr.StatusCode)
+	assert.Equal(int32(2), calls.Load(), "the request should not be attempted more than MaxAttempts times")
+}
+
+func TestRetryNonIdempotent(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createFlakyTestServer(1, http.StatusServiceUnavailable, "", &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRetryPolicy(tines.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond}),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.CreateStory(context.Background(), &tines.Story{TeamID: 1})
+
+	assert.Error(err, "POST requests should not be retried by default")
+	assert.Equal(int32(1), calls.Load(), "POST requests should only be attempted once by default")
+}
+
+func TestRetryCustomPolicy(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createFlakyTestServer(1, http.StatusInternalServerError, "", &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRetryPolicy(tines.RetryPolicy{
+			MaxAttempts: 2,
+			MinBackoff:  time.Millisecond,
+			ShouldRetry: func(method string, statusCode int, err error) bool {
+				return statusCode == http.StatusInternalServerError
+			},
+		}),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.CreateStory(context.Background(), &tines.Story{TeamID: 1})
+
+	assert.Nil(err, "a custom ShouldRetry function should override the defaults")
+	assert.Equal(int32(2), calls.Load())
+}
+
+func TestRetryRespectsContext(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createFlakyTestServer(5, http.StatusServiceUnavailable, "60", &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRetryPolicy(tines.RetryPolicy{MaxAttempts: 3}),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = cli.GetInfo(ctx)
+
+	assert.Error(err, "the request should fail when the context expires while waiting to retry")
+	assert.Less(time.Since(start), 5*time.Second, "the Retry-After wait should be interrupted by the context")
+	assert.Equal(int32(1), calls.Load())
+}
+
+func TestRetryCancelledDuringBackoff(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createFlakyTestServer(5, http.StatusServiceUnavailable, "60", &calls)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetRetryPolicy(tines.RetryPolicy{MaxAttempts: 3}),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err = cli.GetInfo(ctx)
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "the request should fail with a Tines error when cancelled while waiting to retry")
+	assert.ErrorIs(err, context.Canceled, "the cancellation should be preserved in the error chain")
+	assert.Equal(http.MethodGet, tErr.Method, "the error should include the request method")
+	assert.Equal("/api/v1/info", tErr.Path, "the error should include the request path")
+}
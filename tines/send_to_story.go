@@ -0,0 +1,129 @@
+package tines
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Enum for how SendToStory() waits on the story it calls.
+type SendToStoryMode string
+
+const (
+	// Wait for the story to reach its exit action and return the exit action's output.
+	SendToStorySync SendToStoryMode = "sync"
+	// Return as soon as the story run has been queued.
+	SendToStoryAsync SendToStoryMode = "async"
+)
+
+type SendToStoryOptions struct {
+	Mode SendToStoryMode
+}
+
+type sendToStoryRequest struct {
+	Payload     any  `json:"payload"`
+	Synchronous bool `json:"synchronous"`
+	Timeout     int  `json:"timeout,omitempty"`
+}
+
+// The result of calling a story with SendToStory(). Output is only populated for
+// synchronous calls, and holds the event emitted by the story's exit action.
+type SendToStoryResponse struct {
+	StoryRunGuid string          `json:"story_run_guid,omitempty"`
+	Output       json.RawMessage `json:"output,omitempty"`
+}
+
+// Call the story asynchronously. The story run GUID can be passed to ListStoryRunEvents()
+// to follow the progress of the run.
+func WithSendToStoryAsync() func(*SendToStoryOptions) {
+	return func(o *SendToStoryOptions) {
+		o.Mode = SendToStoryAsync
+	}
+}
+
+// Unmarshal the output of a synchronous story call into the provided value.
+func (r *SendToStoryResponse) Decode(v any) error {
+	err := json.Unmarshal(r.Output, v)
+	if err != nil {
+		return Error{
+			Type: ErrorTypeServer,
+			Errors: []ErrorMessage{
+				{
+					Message: errUnmarshalError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+	return nil
+}
+
+// Send a payload to a story that has Send to Story enabled. By default the call is synchronous
+// and waits for the story's exit action to emit an event; if the context has a deadline, the
+// remaining time is passed to Tines as the maximum time to wait. Use WithSendToStoryAsync() to
+// return as soon as the story run has started.
+//
+// Example Usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//
+//	res, err := cli.SendToStory(ctx, 1, map[string]any{"ip": "1.1.1.1"})
+//	if err != nil {
+//		...
+//	}
+//	var out EnrichmentResult
+//	err = res.Decode(&out)
+func (c *Client) SendToStory(ctx context.Context, storyID int, payload any, opts ...func(*SendToStoryOptions)) (*SendToStoryResponse, error) {
+	ctx = withOperation(ctx, "SendToStory")
+	resource := fmt.Sprintf("/api/v1/stories/%d/send_to_story", storyID)
+	o := SendToStoryOptions{
+		Mode: SendToStorySync,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	r := sendToStoryRequest{
+		Payload:     payload,
+		Synchronous: o.Mode == SendToStorySync,
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && r.Synchronous {
+		r.Timeout = int(time.Until(deadline).Seconds())
+		if r.Timeout < 1 {
+			return nil, Error{
+				Type: ErrorTypeRequest,
+				Errors: []ErrorMessage{
+					{
+						Message: errDoRequestError,
+						Details: "context deadline is too short to wait for a synchronous story run",
+					},
+				},
+			}
+		}
+	}
+
+	req, err := json.Marshal(&r)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	res := SendToStoryResponse{}
+
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
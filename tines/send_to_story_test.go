@@ -0,0 +1,107 @@
+package tines_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testSendToStorySyncResp = `
+{
+    "story_run_guid": "b2f3e1d4c5a6978812345678abcdef01",
+    "output": {
+        "reputation": "clean",
+        "score": 0
+    }
+}`
+	// Hand-written example API response.
+	testSendToStoryAsyncResp = `
+{
+    "story_run_guid": "b2f3e1d4c5a6978812345678abcdef01"
+}`
+)
+
+func TestSendToStorySync(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPost, r.Method)
+		assert.Equal("/api/v1/stories/1/send_to_story", r.URL.Path)
+
+		var body struct {
+			Payload     map[string]any `json:"payload"`
+			Synchronous bool           `json:"synchronous"`
+			Timeout     int            `json:"timeout"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.Nil(err, "HTTP request body should be valid JSON")
+		assert.Equal(map[string]any{"ip": "1.1.1.1"}, body.Payload)
+		assert.True(body.Synchronous, "the story should be called synchronously by default")
+		assert.GreaterOrEqual(body.Timeout, 25, "the remaining context time should be sent as the timeout")
+		assert.LessOrEqual(body.Timeout, 30, "the timeout should not exceed the context deadline")
+
+		w.Write([]byte(testSendToStorySyncResp)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	res, err := cli.SendToStory(ctx, 1, map[string]any{"ip": "1.1.1.1"})
+
+	assert.Nil(err, "the story should be called successfully")
+	if err != nil {
+		return
+	}
+
+	var out struct {
+		Reputation string `json:"reputation"`
+	}
+	err = res.Decode(&out)
+	assert.Nil(err, "the story output should be decodable")
+	assert.Equal("clean", out.Reputation, "the exit action output should be returned")
+}
+
+func TestSendToStoryAsync(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/stories/1/send_to_story", http.StatusOK, []byte(`{"payload": {"ip": "1.1.1.1"}, "synchronous": false}`), []byte(testSendToStoryAsyncResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	res, err := cli.SendToStory(ctx, 1, map[string]any{"ip": "1.1.1.1"}, tines.WithSendToStoryAsync())
+
+	assert.Nil(err, "the story should be called successfully")
+	if err != nil {
+		return
+	}
+	assert.Equal("b2f3e1d4c5a6978812345678abcdef01", res.StoryRunGuid, "the story run GUID should be returned")
+	assert.Empty(res.Output, "asynchronous calls should not return output")
+}
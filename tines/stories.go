@@ -44,9 +44,9 @@ type Story struct {
 	Tags                 []string `json:"tags,omitempty"`
 	Guid                 string   `json:"guid,omitempty"`
 	Slug                 string   `json:"slug,omitempty"`
-	CreatedAt            string   `json:"created_at,omitempty"`
-	UpdatedAt            string   `json:"updated_at,omitempty"`
-	EditedAt             string   `json:"edited_at,omitempty"`
+	CreatedAt            Time     `json:"created_at,omitzero"`
+	UpdatedAt            Time     `json:"updated_at,omitzero"`
+	EditedAt             Time     `json:"edited_at,omitzero"`
 	Mode                 string   `json:"mode,omitempty"`
 	FolderID             int      `json:"folder_id,omitempty"`
 	Published            bool     `json:"published,omitempty"`
@@ -63,6 +63,7 @@ type StoryList struct {
 // Create a new story with an empty storyboard. For managing storyboard contents via
 // API, using the ImportStory() function is the recommended approach.
 func (c *Client) CreateStory(ctx context.Context, s *Story) (*Story, error) {
+	ctx = withOperation(ctx, "CreateStory")
 	newStory := Story{}
 
 	req, err := json.Marshal(&s)
@@ -85,6 +86,7 @@ func (c *Client) CreateStory(ctx context.Context, s *Story) (*Story, error) {
 
 // Get current state for a story.
 func (c *Client) GetStory(ctx context.Context, id int) (story *Story, e error) {
+	ctx = withOperation(ctx, "GetStory")
 	resource := fmt.Sprintf("/api/v1/stories/%d", id)
 
 	body, err := c.doRequest(ctx, "GET", resource, nil, nil)
@@ -102,6 +104,7 @@ func (c *Client) GetStory(ctx context.Context, id int) (story *Story, e error) {
 
 // Update a story.
 func (c *Client) UpdateStory(ctx context.Context, id int, values *Story) (*Story, error) {
+	ctx = withOperation(ctx, "UpdateStory")
 	updatedStory := Story{}
 	resource := fmt.Sprintf("/api/v1/stories/%d", id)
 
@@ -138,61 +141,15 @@ func (c *Client) UpdateStory(ctx context.Context, id int, values *Story) (*Story
 //		fmt.Println(s.Name)
 //	}
 func (c *Client) ListStories(ctx context.Context, f ListFilter) iter.Seq2[Story, error] {
-	var storyList, resultList StoryList
+	ctx = withOperation(ctx, "ListStories")
 	resource := "/api/v1/stories"
-	params := f.ToParamMap()
-	page := paginate.Cursor{
-		TotalRequested: f.MaxResults(),
-	}
 
-	c.logger.Debug(fmt.Sprintf("max results requested: %d", page.TotalRequested))
-
-	return func(yield func(Story, error) bool) {
-
-		for !page.MaxResultsReturned() {
-			res, err := c.doRequest(ctx, http.MethodGet, resource, params, nil)
-			if err != nil {
-				yield(Story{}, err)
-				return
-			}
-
-			err = json.Unmarshal(res, &resultList)
-			if err != nil {
-				yield(Story{}, err)
-				return
-			}
-
-			page.UpdatePagination(resultList.Meta)
-			params = page.GetNextPageParams()
-
-			for _, v := range resultList.Stories {
-				storyList.Stories = append(storyList.Stories, v)
-				page.IncrementCounter()
-				if page.MaxResultsReturned() {
-					c.logger.Debug("hit the limit of results to return")
-					break
-				}
-			}
-
-			// Clear the temporary result buffer
-			resultList = StoryList{}
-
-			if !page.ReturnMoreResults() {
-				c.logger.Debug("no more results to return")
-				break
-			}
-		}
-
-		for _, v := range storyList.Stories {
-			if !yield(v, nil) {
-				return
-			}
-		}
-	}
+	return newList[Story](c, resource, "stories", f).All(ctx)
 }
 
 // Delete a story.
 func (c *Client) DeleteStory(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteStory")
 	resource := fmt.Sprintf("/api/v1/stories/%d", id)
 
 	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
@@ -202,6 +159,7 @@ func (c *Client) DeleteStory(ctx context.Context, id int) error {
 
 // Delete a batch of multiple stories via a list of Story IDs.
 func (c *Client) BatchDeleteStories(ctx context.Context, ids []int) error {
+	ctx = withOperation(ctx, "BatchDeleteStories")
 	resource := "/api/v1/stories/batch"
 	payload := make(map[string][]int)
 	payload["ids"] = ids
@@ -221,6 +179,7 @@ func (c *Client) BatchDeleteStories(ctx context.Context, ids []int) error {
 // exported JSON will be able to identify and call those webhooks. If you are exporting
 // a story for sharing or public consumption, we strongly recommend randomizing the URLs.
 func (c *Client) ExportStory(ctx context.Context, id int, randomizeUrls bool) (map[string]interface{}, error) {
+	ctx = withOperation(ctx, "ExportStory")
 	resource := fmt.Sprintf("/api/v1/stories/%d/export", id)
 
 	params := make(map[string]any)
@@ -247,6 +206,7 @@ func (c *Client) ExportStory(ctx context.Context, id int, randomizeUrls bool) (m
 
 // Import a new story, or override an existing one.
 func (c *Client) ImportStory(ctx context.Context, story *StoryImportRequest) (*Story, error) {
+	ctx = withOperation(ctx, "ImportStory")
 	newStory := Story{}
 
 	req, err := json.Marshal(&story)
@@ -364,6 +364,29 @@ func TestListStories(t *testing.T) {
 	}
 }
 
+func TestCollectStories(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testListStoriesResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	stories, err := tines.Collect(cli.ListStories(context.Background(), tines.NewListFilter()))
+
+	assert.Nil(err, "the list of stories should be collected successfully")
+	if assert.Len(stories, 1, "every story should be collected into the slice") {
+		assert.Equal("Test Story", stories[0].Name, "the story name should be retrieved successfully")
+	}
+}
+
 func TestDeleteStory(t *testing.T) {
 	assert := assert.New(t)
 	ts := createTestServer(assert, http.StatusNoContent, nil, nil)
@@ -1,3 +1,151 @@
 package tines
 
-// TODO: This endpoint is not fully implemented in the SDK yet.
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestStatusApproved ChangeRequestStatus = "APPROVED"
+	ChangeRequestStatusCanceled ChangeRequestStatus = "CANCELED"
+	ChangeRequestStatusPending  ChangeRequestStatus = "AWAITING_APPROVAL"
+	ChangeRequestStatusPromoted ChangeRequestStatus = "PROMOTED"
+)
+
+type ChangeRequest struct {
+	ID int `json:"id,omitempty"`
+	// Required field to open a new Change Request.
+	Title       string              `json:"title,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Status      ChangeRequestStatus `json:"status,omitempty"`
+	UserID      int                 `json:"user_id,omitempty"`
+	ApprovedBy  int                 `json:"approved_by_id,omitempty"`
+	CreatedAt   Time                `json:"created_at,omitzero"`
+	UpdatedAt   Time                `json:"updated_at,omitzero"`
+}
+
+// The state of a change-controlled Story, including all Change Requests that have been
+// opened against it.
+type ChangeRequestStory struct {
+	Story
+	ChangeRequests []ChangeRequest `json:"change_requests,omitempty"`
+}
+
+// The difference between the draft and live versions of a change-controlled Story. The
+// structure of the Diff value mirrors the exported storyboard JSON and is returned as-is.
+type ChangeRequestView struct {
+	ChangeRequestID int             `json:"change_request_id,omitempty"`
+	Diff            json.RawMessage `json:"diff,omitempty"`
+}
+
+type changeRequestAction struct {
+	ChangeRequestID int `json:"change_request_id"`
+}
+
+// Open a Change Request for the draft changes on a change-controlled Story. Title is a
+// required parameter.
+func (c *Client) CreateChangeRequest(ctx context.Context, storyID int, cr *ChangeRequest) (*ChangeRequestStory, error) {
+	ctx = withOperation(ctx, "CreateChangeRequest")
+	resource := fmt.Sprintf("/api/v1/stories/%d/change_request", storyID)
+	errs := Error{Type: ErrorTypeRequest}
+
+	if cr.Title == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Change Request Title must not be empty",
+		})
+	}
+
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	req, err := json.Marshal(&ChangeRequest{Title: cr.Title, Description: cr.Description})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doChangeRequest(ctx, http.MethodPost, resource, req)
+}
+
+// Approve an open Change Request. Approval must be granted by a user other than the one
+// who opened the Change Request.
+func (c *Client) ApproveChangeRequest(ctx context.Context, storyID, changeRequestID int) (*ChangeRequestStory, error) {
+	ctx = withOperation(ctx, "ApproveChangeRequest")
+	resource := fmt.Sprintf("/api/v1/stories/%d/change_request/approve", storyID)
+
+	req, err := json.Marshal(&changeRequestAction{ChangeRequestID: changeRequestID})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doChangeRequest(ctx, http.MethodPost, resource, req)
+}
+
+// Promote an approved Change Request, replacing the live version of the Story with the draft.
+func (c *Client) PromoteChangeRequest(ctx context.Context, storyID, changeRequestID int) (*ChangeRequestStory, error) {
+	ctx = withOperation(ctx, "PromoteChangeRequest")
+	resource := fmt.Sprintf("/api/v1/stories/%d/change_request/promote", storyID)
+
+	req, err := json.Marshal(&changeRequestAction{ChangeRequestID: changeRequestID})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doChangeRequest(ctx, http.MethodPost, resource, req)
+}
+
+// Cancel an open Change Request. The draft changes are kept and a new Change Request can be
+// opened for them later.
+func (c *Client) CancelChangeRequest(ctx context.Context, storyID, changeRequestID int) (*ChangeRequestStory, error) {
+	ctx = withOperation(ctx, "CancelChangeRequest")
+	resource := fmt.Sprintf("/api/v1/stories/%d/change_request/cancel", storyID)
+
+	req, err := json.Marshal(&changeRequestAction{ChangeRequestID: changeRequestID})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doChangeRequest(ctx, http.MethodPost, resource, req)
+}
+
+// Get the difference between the draft and live versions of a change-controlled Story.
+func (c *Client) GetChangeRequestView(ctx context.Context, storyID int) (*ChangeRequestView, error) {
+	ctx = withOperation(ctx, "GetChangeRequestView")
+	resource := fmt.Sprintf("/api/v1/stories/%d/change_request/view", storyID)
+	view := struct {
+		ChangeRequestView ChangeRequestView `json:"change_request_view"`
+	}{}
+
+	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &view)
+	if err != nil {
+		return nil, err
+	}
+
+	return &view.ChangeRequestView, nil
+}
+
+func (c *Client) doChangeRequest(ctx context.Context, method, resource string, data []byte) (*ChangeRequestStory, error) {
+	story := ChangeRequestStory{}
+
+	body, err := c.doRequest(ctx, method, resource, nil, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &story)
+	if err != nil {
+		return nil, err
+	}
+
+	return &story, nil
+}
@@ -0,0 +1,145 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testChangeRequestResp = `
+{
+    "id": 1,
+    "name": "Test Story",
+    "team_id": 1,
+    "guid": "a72744c526e7d3e5b608f130a583c98b",
+    "slug": "test_story",
+    "mode": "LIVE",
+    "change_control_enabled": true,
+    "change_requests": [
+        {
+            "id": 1,
+            "title": "Update webhook payload",
+            "description": "Adds a new field",
+            "status": "AWAITING_APPROVAL",
+            "user_id": 1,
+            "approved_by_id": null,
+            "created_at": "2025-06-02T00:00:00Z",
+            "updated_at": "2025-06-02T00:00:00Z"
+        }
+    ]
+}`
+	// Hand-written example API response.
+	testChangeRequestViewResp = `
+{
+    "change_request_view": {
+        "change_request_id": 1,
+        "diff": {
+            "agents": {
+                "changed": ["6bba07417c1732ae4b2e7b642dcc9cea"]
+            }
+        }
+    }
+}`
+)
+
+func TestCreateChangeRequest(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/stories/1/change_request", http.StatusOK, []byte(`{"title": "Update webhook payload", "description": "Adds a new field"}`), []byte(testChangeRequestResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	cr := tines.ChangeRequest{
+		Title:       "Update webhook payload",
+		Description: "Adds a new field",
+	}
+
+	res, err := cli.CreateChangeRequest(ctx, 1, &cr)
+
+	assert.Nil(err, "the Tines client should open a change request successfully")
+	assert.True(res.ChangeControlEnabled, "the story should be change controlled")
+	assert.Len(res.ChangeRequests, 1, "the opened change request should be returned")
+
+	_, err = cli.CreateChangeRequest(ctx, 1, &tines.ChangeRequest{})
+	assert.Error(err, "the Tines client should refuse to open a change request without a title")
+}
+
+func TestChangeRequestActions(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name string
+		path string
+		fn   func(*tines.Client) (*tines.ChangeRequestStory, error)
+	}{
+		{"Approve", "/api/v1/stories/1/change_request/approve", func(cli *tines.Client) (*tines.ChangeRequestStory, error) {
+			return cli.ApproveChangeRequest(context.Background(), 1, 1)
+		}},
+		{"Promote", "/api/v1/stories/1/change_request/promote", func(cli *tines.Client) (*tines.ChangeRequestStory, error) {
+			return cli.PromoteChangeRequest(context.Background(), 1, 1)
+		}},
+		{"Cancel", "/api/v1/stories/1/change_request/cancel", func(cli *tines.Client) (*tines.ChangeRequestStory, error) {
+			return cli.CancelChangeRequest(context.Background(), 1, 1)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := createRouteTestServer(assert, http.MethodPost, test.path, http.StatusOK, []byte(`{"change_request_id": 1}`), []byte(testChangeRequestResp))
+			defer ts.Close()
+
+			cli, err := tines.NewClient(
+				tines.SetApiKey("foo"),
+				tines.SetTenantUrl(ts.URL),
+			)
+
+			assert.Nil(err, "the Tines CLI client should instantiate successfully")
+			if err != nil {
+				return
+			}
+
+			res, err := test.fn(cli)
+
+			assert.Nil(err, "the change request action should succeed")
+			assert.Equal("Test Story", res.Name, "the change-controlled story should be returned")
+		})
+	}
+}
+
+func TestGetChangeRequestView(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/stories/1/change_request/view", http.StatusOK, nil, []byte(testChangeRequestViewResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	view, err := cli.GetChangeRequestView(ctx, 1)
+
+	assert.Nil(err, "the Tines client should retrieve the change request view successfully")
+	assert.Equal(1, view.ChangeRequestID, "the change request ID should be parsed")
+	assert.JSONEq(`{"agents": {"changed": ["6bba07417c1732ae4b2e7b642dcc9cea"]}}`, string(view.Diff), "the draft/live diff should be returned")
+}
@@ -1,3 +1,71 @@
 package tines
 
-// TODO: This endpoint is not fully implemented in the SDK yet.
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+type StoryRun struct {
+	Guid        string `json:"guid,omitempty"`
+	StoryID     int    `json:"story_id,omitempty"`
+	StoryMode   string `json:"story_mode,omitempty"`
+	Duration    int    `json:"duration,omitempty"`
+	ActionCount int    `json:"action_count,omitempty"`
+	EventCount  int    `json:"event_count,omitempty"`
+	StartTime   Time   `json:"start_time,omitzero"`
+	EndTime     Time   `json:"end_time,omitzero"`
+}
+
+type StoryRunList struct {
+	StoryRuns []StoryRun    `json:"story_runs,omitempty"`
+	Meta      paginate.Meta `json:"meta,omitempty"`
+}
+
+type StoryRunEventList struct {
+	StoryRunEvents []Event       `json:"story_run_events,omitempty"`
+	Meta           paginate.Meta `json:"meta,omitempty"`
+}
+
+// Yields an iterator that returns individual runs of a Story, optionally filtered to runs
+// that started before or after a given timestamp. If no other filters are specified,
+// ListStoryRuns() will recurse through all pages of results until no more are available.
+// If `filters.WithMaxResults()` is set, this function will yield either the actual set of
+// results or the specified maximum number of results, whichever is less.
+//
+// Example Usage:
+//
+//	lf := NewListFilter(WithResultsAfter("2025-01-01"))
+//	for r, err := range ListStoryRuns(ctx, 1, lf) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(r.Guid)
+//	}
+func (c *Client) ListStoryRuns(ctx context.Context, storyID int, f ListFilter) iter.Seq2[StoryRun, error] {
+	ctx = withOperation(ctx, "ListStoryRuns")
+	resource := fmt.Sprintf("/api/v1/stories/%d/runs", storyID)
+
+	return newList[StoryRun](c, resource, "story_runs", f).All(ctx)
+}
+
+// Yields an iterator that returns every Event emitted during a single Story run, identified
+// by the run GUID. Unlike other List functions, all pages of results are always returned so
+// that the complete trace of the run can be rebuilt.
+//
+// Example Usage:
+//
+//	for e, err := range ListStoryRunEvents(ctx, 1, "a72744c526e7d3e5b608f130a583c98b") {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(e.ActionID)
+//	}
+func (c *Client) ListStoryRunEvents(ctx context.Context, storyID int, runGUID string) iter.Seq2[Event, error] {
+	ctx = withOperation(ctx, "ListStoryRunEvents")
+	resource := fmt.Sprintf("/api/v1/stories/%d/runs/%s", storyID, runGUID)
+
+	return newList[Event](c, resource, "story_run_events", ListFilter{}).All(ctx)
+}
@@ -0,0 +1,147 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testListStoryRunsResp = `
+{
+    "story_runs": [
+        {
+            "guid": "b2f3e1d4c5a6978812345678abcdef01",
+            "duration": 2,
+            "story_id": 1,
+            "start_time": "2025-06-02T00:00:00Z",
+            "end_time": "2025-06-02T00:00:02Z",
+            "action_count": 2,
+            "event_count": 2,
+            "story_mode": "LIVE"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/stories/1/runs?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+	// Hand-written example API response.
+	testListStoryRunEventsResp = `
+{
+    "story_run_events": [
+        {
+            "id": 1,
+            "agent_id": 1,
+            "story_id": 1,
+            "story_run_guid": "b2f3e1d4c5a6978812345678abcdef01",
+            "previous_events_ids": [],
+            "payload": {
+                "body": {
+                    "foo": "bar"
+                }
+            },
+            "created_at": "2025-06-02T00:00:00Z",
+            "updated_at": "2025-06-02T00:00:00Z"
+        },
+        {
+            "id": 2,
+            "agent_id": 2,
+            "story_id": 1,
+            "story_run_guid": "b2f3e1d4c5a6978812345678abcdef01",
+            "previous_events_ids": [1],
+            "payload": {
+                "message": "This is an automatically generated message from Tines"
+            },
+            "created_at": "2025-06-02T00:00:02Z",
+            "updated_at": "2025-06-02T00:00:02Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/stories/1/runs/b2f3e1d4c5a6978812345678abcdef01?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 2
+    }
+}`
+)
+
+func TestListStoryRuns(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodGet, r.Method, "client should use the expected HTTP method")
+		assert.Equal("/api/v1/stories/1/runs", r.URL.Path, "client should call the expected endpoint")
+		q := r.URL.Query()
+		assert.Equal("2025-06-01T00:00:00Z", q.Get("after"), "the after filter should be sent")
+		assert.Equal("2025-06-03T00:00:00Z", q.Get("before"), "the before filter should be sent")
+		w.Write([]byte(testListStoryRunsResp)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	lf := tines.NewListFilter(
+		tines.WithResultsAfter("2025-06-01"),
+		tines.WithResultsBefore("2025-06-03"),
+	)
+
+	for r, err := range cli.ListStoryRuns(ctx, 1, lf) {
+		assert.Nil(err, "the list of story runs should be iterable")
+		assert.Equal("b2f3e1d4c5a6978812345678abcdef01", r.Guid, "the story run GUID should be retrieved successfully")
+		assert.Equal(2, r.EventCount, "the story run event count should be retrieved successfully")
+	}
+}
+
+func TestListStoryRunEvents(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/stories/1/runs/b2f3e1d4c5a6978812345678abcdef01", http.StatusOK, nil, []byte(testListStoryRunEventsResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	var events []tines.Event
+	for e, err := range cli.ListStoryRunEvents(ctx, 1, "b2f3e1d4c5a6978812345678abcdef01") {
+		assert.Nil(err, "the list of story run events should be iterable")
+		events = append(events, e)
+	}
+
+	assert.Len(events, 2, "every event in the story run should be returned")
+	if len(events) != 2 {
+		return
+	}
+	assert.Equal([]int{1}, events[1].PreviousEventIDs, "the event lineage should be retrieved successfully")
+	assert.JSONEq(`{"body": {"foo": "bar"}}`, string(events[0].Payload), "the event payload should be retrieved successfully")
+}
@@ -1,3 +1,173 @@
 package tines
 
-// TODO: This endpoint is not fully implemented in the SDK yet.
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+type StoryVersion struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Timestamp   Time   `json:"timestamp,omitzero"`
+	CreatedAt   Time   `json:"created_at,omitzero"`
+	UpdatedAt   Time   `json:"updated_at,omitzero"`
+	// The storyboard contents at the time the version was saved, in the same format returned
+	// by ExportStory(). Only populated by GetStoryVersion().
+	ExportFile map[string]interface{} `json:"export_file,omitempty"`
+}
+
+type StoryVersionList struct {
+	StoryVersions []StoryVersion `json:"story_versions,omitempty"`
+	Meta          paginate.Meta  `json:"meta,omitempty"`
+}
+
+// Save the current state of a story as a new named version.
+func (c *Client) CreateStoryVersion(ctx context.Context, storyID int, name string) (*StoryVersion, error) {
+	ctx = withOperation(ctx, "CreateStoryVersion")
+	resource := fmt.Sprintf("/api/v1/stories/%d/versions", storyID)
+	newVersion := StoryVersion{}
+
+	req, err := json.Marshal(&StoryVersion{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &newVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newVersion, nil
+}
+
+// Get a single version of a story, including the exported storyboard contents.
+func (c *Client) GetStoryVersion(ctx context.Context, storyID, versionID int) (*StoryVersion, error) {
+	ctx = withOperation(ctx, "GetStoryVersion")
+	resource := fmt.Sprintf("/api/v1/stories/%d/versions/%d", storyID, versionID)
+	version := StoryVersion{}
+
+	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// Rename a story version. The name is the only attribute of a version that can be updated.
+func (c *Client) UpdateStoryVersion(ctx context.Context, storyID, versionID int, name string) (*StoryVersion, error) {
+	ctx = withOperation(ctx, "UpdateStoryVersion")
+	resource := fmt.Sprintf("/api/v1/stories/%d/versions/%d", storyID, versionID)
+	updatedVersion := StoryVersion{}
+
+	req, err := json.Marshal(&StoryVersion{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &updatedVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedVersion, nil
+}
+
+// Yields an iterator that returns the saved versions of a story. If no other filters are
+// specified, ListStoryVersions() will recurse through all pages of results until no more are
+// available. If `filters.WithMaxResults()` is set, this function will yield either the actual
+// set of results or the specified maximum number of results, whichever is less.
+//
+// Example Usage:
+//
+//	for v, err := range ListStoryVersions(ctx, 1, NewListFilter()) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(v.Name)
+//	}
+func (c *Client) ListStoryVersions(ctx context.Context, storyID int, f ListFilter) iter.Seq2[StoryVersion, error] {
+	ctx = withOperation(ctx, "ListStoryVersions")
+	resource := fmt.Sprintf("/api/v1/stories/%d/versions", storyID)
+
+	return newList[StoryVersion](c, resource, "story_versions", f).All(ctx)
+}
+
+// Delete a story version. The current state of the story is not affected.
+func (c *Client) DeleteStoryVersion(ctx context.Context, storyID, versionID int) error {
+	ctx = withOperation(ctx, "DeleteStoryVersion")
+	resource := fmt.Sprintf("/api/v1/stories/%d/versions/%d", storyID, versionID)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
+
+// Export the storyboard contents of a saved story version to JSON. The result has the same
+// shape as the output of ExportStory(), so it can be passed directly to ImportStory().
+func (c *Client) ExportStoryVersion(ctx context.Context, storyID, versionID int) (map[string]interface{}, error) {
+	ctx = withOperation(ctx, "ExportStoryVersion")
+	version, err := c.GetStoryVersion(ctx, storyID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.ExportFile == nil {
+		return nil, Error{
+			Type: ErrorTypeServer,
+			Errors: []ErrorMessage{
+				{
+					Message: errUnmarshalError,
+					Details: fmt.Sprintf("story version %d did not include an export file", versionID),
+				},
+			},
+		}
+	}
+
+	return version.ExportFile, nil
+}
+
+// Roll a story back to a previously saved version by re-importing the version's export
+// over the existing story with StoryModeReplace. The story keeps its current name, team,
+// and folder.
+func (c *Client) RestoreStoryVersion(ctx context.Context, storyID, versionID int) (*Story, error) {
+	ctx = withOperation(ctx, "RestoreStoryVersion")
+	story, err := c.GetStory(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+
+	export, err := c.ExportStoryVersion(ctx, storyID, versionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ImportStory(ctx, &StoryImportRequest{
+		NewName:  story.Name,
+		Data:     export,
+		TeamID:   story.TeamID,
+		FolderID: story.FolderID,
+		Mode:     StoryModeReplace,
+	})
+}
@@ -0,0 +1,233 @@
+package tines_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testStoryVersionResp = `
+{
+    "id": 1,
+    "name": "Before import",
+    "description": null,
+    "timestamp": "2025-06-02T00:00:00Z",
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:00:00Z"
+}`
+	// Hand-written example API response.
+	testGetStoryVersionResp = `
+{
+    "id": 1,
+    "name": "Before import",
+    "description": null,
+    "timestamp": "2025-06-02T00:00:00Z",
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:00:00Z",
+    "export_file": {
+        "schema_version": 23,
+        "name": "Test Story",
+        "guid": "3ef721e341e953727b057d4bd7bd65eb",
+        "agents": [],
+        "links": []
+    }
+}`
+	// Hand-written example API response.
+	testListStoryVersionsResp = `
+{
+    "story_versions": [
+        {
+            "id": 1,
+            "name": "Before import",
+            "description": null,
+            "timestamp": "2025-06-02T00:00:00Z",
+            "created_at": "2025-06-02T00:00:00Z",
+            "updated_at": "2025-06-02T00:00:00Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/stories/1/versions?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+)
+
+func TestCreateStoryVersion(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/stories/1/versions", http.StatusCreated, []byte(`{"name": "Before import"}`), []byte(testStoryVersionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	v, err := cli.CreateStoryVersion(ctx, 1, "Before import")
+
+	assert.Nil(err, "the Tines client should create a story version successfully")
+	assert.Equal(1, v.ID, "the story version ID should be parsed")
+}
+
+func TestGetStoryVersion(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/stories/1/versions/1", http.StatusOK, nil, []byte(testGetStoryVersionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	v, err := cli.GetStoryVersion(ctx, 1, 1)
+
+	assert.Nil(err, "the Tines client should retrieve a story version successfully")
+	assert.Equal("Before import", v.Name, "the story version name should be parsed")
+	assert.False(v.Timestamp.IsZero(), "the story version timestamp should be valid")
+
+	export, err := cli.ExportStoryVersion(ctx, 1, 1)
+
+	assert.Nil(err, "the Tines client should export a story version successfully")
+	assert.Equal("Test Story", export["name"], "the exported story version should be valid JSON")
+}
+
+func TestUpdateStoryVersion(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/stories/1/versions/1", http.StatusOK, []byte(`{"name": "Before import"}`), []byte(testStoryVersionResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	v, err := cli.UpdateStoryVersion(ctx, 1, 1, "Before import")
+
+	assert.Nil(err, "the Tines client should update a story version successfully")
+	assert.Equal("Before import", v.Name, "the story version name should be updated")
+}
+
+func TestListStoryVersions(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/stories/1/versions", http.StatusOK, nil, []byte(testListStoryVersionsResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for v, err := range cli.ListStoryVersions(ctx, 1, tines.NewListFilter()) {
+		assert.Nil(err, "the list of story versions should be iterable")
+		assert.Equal("Before import", v.Name, "the story version name should be retrieved successfully")
+	}
+}
+
+func TestDeleteStoryVersion(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/stories/1/versions/1", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.DeleteStoryVersion(ctx, 1, 1)
+
+	assert.Nil(err, "the Tines client should delete the story version successfully")
+}
+
+func TestRestoreStoryVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/stories/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testStoryResp)) //nolint:errcheck
+	})
+	mux.HandleFunc("GET /api/v1/stories/1/versions/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(testGetStoryVersionResp)) //nolint:errcheck
+	})
+	mux.HandleFunc("POST /api/v1/stories/import", func(w http.ResponseWriter, r *http.Request) {
+		reqBody, err := io.ReadAll(r.Body)
+		assert.Nil(err, "HTTP request body should be readable")
+		assert.JSONEq(`{
+			"new_name": "New Story",
+			"team_id": 1,
+			"mode": "versionReplace",
+			"data": {
+				"schema_version": 23,
+				"name": "Test Story",
+				"guid": "3ef721e341e953727b057d4bd7bd65eb",
+				"agents": [],
+				"links": []
+			}
+		}`, string(reqBody), "the version export should be imported over the existing story")
+		w.Write([]byte(testStoryResp)) //nolint:errcheck
+	})
+	ts := httptest.NewServer(mux)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	story, err := cli.RestoreStoryVersion(ctx, 1, 1)
+
+	assert.Nil(err, "the Tines client should restore the story version successfully")
+	assert.Equal("New Story", story.Name, "the restored story should keep its name")
+}
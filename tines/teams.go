@@ -0,0 +1,264 @@
+package tines
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+type TeamRole string
+
+const (
+	TeamRoleViewer    TeamRole = "VIEWER"
+	TeamRoleEditor    TeamRole = "EDITOR"
+	TeamRoleTeamAdmin TeamRole = "TEAM_ADMIN"
+)
+
+type Team struct {
+	ID     int         `json:"id,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Groups []TeamGroup `json:"groups,omitempty"`
+}
+
+type TeamGroup struct {
+	ID   int    `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type TeamList struct {
+	Teams []Team        `json:"teams,omitempty"`
+	Meta  paginate.Meta `json:"meta,omitempty"`
+}
+
+type TeamMember struct {
+	ID             int      `json:"id,omitempty"`
+	FirstName      string   `json:"first_name,omitempty"`
+	LastName       string   `json:"last_name,omitempty"`
+	Email          string   `json:"email,omitempty"`
+	IsAdmin        bool     `json:"is_admin,omitempty"`
+	Role           TeamRole `json:"role,omitempty"`
+	InviteAccepted bool     `json:"invite_accepted,omitempty"`
+	CreatedAt      Time     `json:"created_at,omitzero"`
+	LastSeen       Time     `json:"last_seen,omitzero"`
+}
+
+type TeamMemberList struct {
+	Members []TeamMember  `json:"members,omitempty"`
+	Meta    paginate.Meta `json:"meta,omitempty"`
+}
+
+type teamMemberRequest struct {
+	UserID int      `json:"user_id,omitempty"`
+	Email  string   `json:"email,omitempty"`
+	Role   TeamRole `json:"role,omitempty"`
+}
+
+// Create a new Team. Name is a required parameter.
+func (c *Client) CreateTeam(ctx context.Context, name string) (*Team, error) {
+	ctx = withOperation(ctx, "CreateTeam")
+	resource := "/api/v1/teams"
+	errs := Error{Type: ErrorTypeRequest}
+	newTeam := Team{}
+
+	if name == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Team Name must not be empty",
+		})
+	}
+
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	req, err := json.Marshal(&Team{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &newTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newTeam, nil
+}
+
+// Get a Team by unique ID.
+func (c *Client) GetTeam(ctx context.Context, id int) (*Team, error) {
+	ctx = withOperation(ctx, "GetTeam")
+	resource := fmt.Sprintf("/api/v1/teams/%d", id)
+	team := Team{}
+
+	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &team)
+	if err != nil {
+		return nil, err
+	}
+
+	return &team, nil
+}
+
+// Update a Team by unique ID. The only attribute that can be updated is the team name.
+func (c *Client) UpdateTeam(ctx context.Context, id int, name string) (*Team, error) {
+	ctx = withOperation(ctx, "UpdateTeam")
+	resource := fmt.Sprintf("/api/v1/teams/%d", id)
+	updatedTeam := Team{}
+
+	req, err := json.Marshal(&Team{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &updatedTeam)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedTeam, nil
+}
+
+// Yields an iterator that returns individual Teams. If no other filters are specified,
+// ListTeams() will recurse through all pages of results until no more are available. If
+// `filters.WithMaxResults()` is set, this function will yield either the actual set of
+// results or the specified maximum number of results, whichever is less.
+//
+// Example Usage:
+//
+//	for t, err := range ListTeams(ctx, NewListFilter()) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(t.Name)
+//	}
+func (c *Client) ListTeams(ctx context.Context, f ListFilter) iter.Seq2[Team, error] {
+	ctx = withOperation(ctx, "ListTeams")
+	resource := "/api/v1/teams"
+
+	return newList[Team](c, resource, "teams", f).All(ctx)
+}
+
+// Delete a Team by unique ID. All stories, credentials, and resources owned by the team
+// are deleted along with it.
+func (c *Client) DeleteTeam(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteTeam")
+	resource := fmt.Sprintf("/api/v1/teams/%d", id)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
+
+// Yields an iterator that returns the members of a Team. If no other filters are specified,
+// ListTeamMembers() will recurse through all pages of results until no more are available. If
+// `filters.WithMaxResults()` is set, this function will yield either the actual set of results
+// or the specified maximum number of results, whichever is less.
+//
+// Example Usage:
+//
+//	for m, err := range ListTeamMembers(ctx, 1, NewListFilter()) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(m.Email)
+//	}
+func (c *Client) ListTeamMembers(ctx context.Context, teamID int, f ListFilter) iter.Seq2[TeamMember, error] {
+	ctx = withOperation(ctx, "ListTeamMembers")
+	resource := fmt.Sprintf("/api/v1/teams/%d/members", teamID)
+
+	return newList[TeamMember](c, resource, "members", f).All(ctx)
+}
+
+// Invite a user to a Team by email address. If no role is specified, the API default of
+// TeamRoleEditor is applied.
+func (c *Client) InviteTeamMember(ctx context.Context, teamID int, email string, role TeamRole) (*TeamMember, error) {
+	ctx = withOperation(ctx, "InviteTeamMember")
+	resource := fmt.Sprintf("/api/v1/teams/%d/invite_member", teamID)
+	errs := Error{Type: ErrorTypeRequest}
+	member := TeamMember{}
+
+	if email == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Team Member email must not be empty",
+		})
+	}
+
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	req, err := json.Marshal(&teamMemberRequest{Email: email, Role: role})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &member)
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
+
+// Remove a user from a Team. The user account itself is not deleted.
+func (c *Client) RemoveTeamMember(ctx context.Context, teamID, userID int) error {
+	ctx = withOperation(ctx, "RemoveTeamMember")
+	resource := fmt.Sprintf("/api/v1/teams/%d/remove_member", teamID)
+
+	req, err := json.Marshal(&teamMemberRequest{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.doRequest(ctx, http.MethodPost, resource, nil, req)
+
+	return err
+}
+
+// Change the role of an existing member of a Team.
+func (c *Client) ChangeTeamMemberRole(ctx context.Context, teamID, userID int, role TeamRole) (*TeamMember, error) {
+	ctx = withOperation(ctx, "ChangeTeamMemberRole")
+	resource := fmt.Sprintf("/api/v1/teams/%d/change_member_role", teamID)
+	member := TeamMember{}
+
+	req, err := json.Marshal(&teamMemberRequest{UserID: userID, Role: role})
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &member)
+	if err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
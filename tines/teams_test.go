@@ -0,0 +1,296 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testTeamResp = `
+{
+    "id": 1,
+    "name": "Test Team",
+    "groups": []
+}`
+	// Hand-written example API response.
+	testListTeamsResp = `
+{
+    "teams": [
+        {
+            "id": 1,
+            "name": "Test Team",
+            "groups": [
+                {
+                    "id": 1,
+                    "name": "Test Group"
+                }
+            ]
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/teams?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+	// Hand-written example API response.
+	testTeamMemberResp = `
+{
+    "id": 2,
+    "first_name": "Example",
+    "last_name": "User",
+    "email": "user@example.com",
+    "is_admin": false,
+    "role": "EDITOR",
+    "invite_accepted": false,
+    "created_at": "2025-06-02T00:00:00Z",
+    "last_seen": null
+}`
+	// Hand-written example API response.
+	testListTeamMembersResp = `
+{
+    "members": [
+        {
+            "id": 2,
+            "first_name": "Example",
+            "last_name": "User",
+            "email": "user@example.com",
+            "is_admin": false,
+            "role": "EDITOR",
+            "invite_accepted": true,
+            "created_at": "2025-06-02T00:00:00Z",
+            "last_seen": "2025-06-02T00:00:00Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/teams/1/members?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+)
+
+func TestCreateTeam(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/teams", http.StatusCreated, []byte(`{"name": "Test Team"}`), []byte(testTeamResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	team, err := cli.CreateTeam(ctx, "Test Team")
+
+	assert.Nil(err, "the Tines client should create a team successfully")
+	assert.Equal(1, team.ID, "the created team ID should be parsed")
+
+	_, err = cli.CreateTeam(ctx, "")
+	assert.Error(err, "the Tines client should refuse to create a team without a name")
+}
+
+func TestGetTeam(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/teams/1", http.StatusOK, nil, []byte(testTeamResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	team, err := cli.GetTeam(ctx, 1)
+
+	assert.Nil(err, "the Tines client should retrieve a team successfully")
+	assert.Equal("Test Team", team.Name, "the team name should be parsed")
+}
+
+func TestUpdateTeam(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/teams/1", http.StatusOK, []byte(`{"name": "Test Team"}`), []byte(testTeamResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	team, err := cli.UpdateTeam(ctx, 1, "Test Team")
+
+	assert.Nil(err, "the Tines client should update a team successfully")
+	assert.Equal("Test Team", team.Name, "the team name should be updated")
+}
+
+func TestListTeams(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/teams", http.StatusOK, nil, []byte(testListTeamsResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for team, err := range cli.ListTeams(ctx, tines.NewListFilter()) {
+		assert.Nil(err, "the list of teams should be iterable")
+		assert.Equal("Test Team", team.Name, "the team name should be retrieved successfully")
+		assert.Len(team.Groups, 1, "the team groups should be retrieved successfully")
+	}
+}
+
+func TestDeleteTeam(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/teams/1", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.DeleteTeam(ctx, 1)
+
+	assert.Nil(err, "the Tines client should delete the team successfully")
+}
+
+func TestListTeamMembers(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/teams/1/members", http.StatusOK, nil, []byte(testListTeamMembersResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for m, err := range cli.ListTeamMembers(ctx, 1, tines.NewListFilter()) {
+		assert.Nil(err, "the list of team members should be iterable")
+		assert.Equal("user@example.com", m.Email, "the team member email should be retrieved successfully")
+		assert.Equal(tines.TeamRoleEditor, m.Role, "the team member role should be retrieved successfully")
+		assert.False(m.LastSeen.IsZero(), "the team member last seen timestamp should be valid")
+	}
+}
+
+func TestInviteTeamMember(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/teams/1/invite_member", http.StatusOK, []byte(`{"email": "user@example.com", "role": "EDITOR"}`), []byte(testTeamMemberResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	m, err := cli.InviteTeamMember(ctx, 1, "user@example.com", tines.TeamRoleEditor)
+
+	assert.Nil(err, "the Tines client should invite a team member successfully")
+	assert.Equal(2, m.ID, "the invited team member ID should be parsed")
+	assert.True(m.LastSeen.IsZero(), "a team member who was never seen should have a zero last seen timestamp")
+}
+
+func TestRemoveTeamMember(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/teams/1/remove_member", http.StatusNoContent, []byte(`{"user_id": 2}`), nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.RemoveTeamMember(ctx, 1, 2)
+
+	assert.Nil(err, "the Tines client should remove the team member successfully")
+}
+
+func TestChangeTeamMemberRole(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/teams/1/change_member_role", http.StatusOK, []byte(`{"user_id": 2, "role": "EDITOR"}`), []byte(testTeamMemberResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	m, err := cli.ChangeTeamMemberRole(ctx, 1, 2, tines.TeamRoleEditor)
+
+	assert.Nil(err, "the Tines client should change the team member role successfully")
+	assert.Equal(tines.TeamRoleEditor, m.Role, "the team member role should be updated")
+}
@@ -0,0 +1,158 @@
+package tines
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Attribute keys set on the span for each API call. The method, path, and status code keys
+// follow the OpenTelemetry semantic conventions for HTTP clients.
+const (
+	AttrHttpMethod     = "http.request.method"
+	AttrUrlPath        = "url.path"
+	AttrHttpStatusCode = "http.response.status_code"
+	AttrPage           = "tines.page"
+	AttrRetryCount     = "tines.retry_count"
+)
+
+const defaultOperation = "tines.Request"
+
+// Receives traces and metrics for every API call made by a Client. The SDK does not depend on
+// a particular telemetry library: the github.com/tines/go-sdk/otel module provides an
+// implementation that reports to OpenTelemetry, and adapters for other libraries only need to
+// forward each call to a tracer and a few instruments.
+type Telemetry interface {
+	// Start a span for a single API call. The name is the SDK operation that made the call,
+	// such as "tines.ListStories". The returned context is used for the HTTP request.
+	StartSpan(ctx context.Context, name string) (context.Context, Span)
+	// Record the outcome of a single API call, once all retries have finished.
+	RecordRequest(ctx context.Context, r RequestRecord)
+	// Record that a List iterator fetched a page of results.
+	RecordPage(ctx context.Context, operation string, page int)
+}
+
+// A span started by Telemetry.StartSpan().
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// A span attribute. The Value is either a string or an int.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// The outcome of a single API call, passed to Telemetry.RecordRequest().
+type RequestRecord struct {
+	Operation  string
+	Method     string
+	Path       string
+	StatusCode int
+	// The number of retries made after the first attempt.
+	Retries int
+	// The total time taken by the call, including retries and rate limiting.
+	Latency time.Duration
+	// The ErrorType of the error returned by the call, or empty if the call succeeded.
+	ErrorType ErrorType
+}
+
+// You may optionally pass a Telemetry implementation when creating a new client to trace every
+// API call and record request latency, errors, and pages fetched.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetTelemetry(tel),
+//	)
+func SetTelemetry(t Telemetry) func(*Client) {
+	return func(c *Client) {
+		c.telemetry = t
+	}
+}
+
+type operationKey struct{}
+type pageKey struct{}
+
+// Name the SDK operation that API calls made with the returned context belong to. If the context
+// already names an operation, it is kept, so that calls made by one SDK function on behalf of
+// another are attributed to the function the caller used.
+func withOperation(ctx context.Context, name string) context.Context {
+	if _, ok := ctx.Value(operationKey{}).(string); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, operationKey{}, "tines."+name)
+}
+
+func operationFromContext(ctx context.Context) string {
+	if op, ok := ctx.Value(operationKey{}).(string); ok {
+		return op
+	}
+	return defaultOperation
+}
+
+func withPage(ctx context.Context, page int) context.Context {
+	return context.WithValue(ctx, pageKey{}, page)
+}
+
+// Return the page number requested by a set of List query parameters.
+func pageNumber(params map[string]any) int {
+	switch v := params["page"].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case string:
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+	}
+	return 1
+}
+
+func (c *Client) recordRequest(ctx context.Context, span Span, op, method, path string, stats requestStats, latency time.Duration, err error) {
+	r := RequestRecord{
+		Operation:  op,
+		Method:     method,
+		Path:       path,
+		StatusCode: stats.statusCode,
+		Retries:    max(stats.attempts-1, 0),
+		Latency:    latency,
+	}
+
+	attrs := []Attribute{
+		{Key: AttrHttpMethod, Value: method},
+		{Key: AttrUrlPath, Value: path},
+		{Key: AttrRetryCount, Value: r.Retries},
+	}
+	if r.StatusCode != 0 {
+		attrs = append(attrs, Attribute{Key: AttrHttpStatusCode, Value: r.StatusCode})
+	}
+	if page, ok := ctx.Value(pageKey{}).(int); ok {
+		attrs = append(attrs, Attribute{Key: AttrPage, Value: page})
+	}
+	span.SetAttributes(attrs...)
+
+	if err != nil {
+		span.RecordError(err)
+
+		r.ErrorType = ErrorTypeRequest
+		var tErr Error
+		if errors.As(err, &tErr) && tErr.Type != "" {
+			r.ErrorType = tErr.Type
+		}
+	}
+
+	c.telemetry.RecordRequest(ctx, r)
+}
+
+func (c *Client) recordPage(ctx context.Context, page int) {
+	if c.telemetry != nil {
+		c.telemetry.RecordPage(ctx, operationFromContext(ctx), page)
+	}
+}
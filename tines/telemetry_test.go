@@ -0,0 +1,135 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+type testSpan struct {
+	name  string
+	attrs map[string]any
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttributes(attrs ...tines.Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *testSpan) RecordError(err error) {
+	s.err = err
+}
+
+func (s *testSpan) End() {
+	s.ended = true
+}
+
+type testTelemetry struct {
+	mu       sync.Mutex
+	spans    []*testSpan
+	requests []tines.RequestRecord
+	pages    []int
+}
+
+func (t *testTelemetry) StartSpan(ctx context.Context, name string) (context.Context, tines.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := &testSpan{name: name, attrs: map[string]any{}}
+	t.spans = append(t.spans, s)
+	return ctx, s
+}
+
+func (t *testTelemetry) RecordRequest(ctx context.Context, r tines.RequestRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.requests = append(t.requests, r)
+}
+
+func (t *testTelemetry) RecordPage(ctx context.Context, operation string, page int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pages = append(t.pages, page)
+}
+
+func TestTelemetryList(t *testing.T) {
+	assert := assert.New(t)
+	var calls atomic.Int32
+	ts := createPagedAuditLogServer(3, &calls)
+	defer ts.Close()
+
+	tel := &testTelemetry{}
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetTelemetry(tel),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	for _, err := range cli.ListAuditLogs(context.Background(), tines.NewListFilter()) {
+		assert.Nil(err)
+	}
+
+	assert.Equal([]int{1, 2, 3}, tel.pages, "every page fetched should be recorded")
+	if assert.Len(tel.spans, 3, "every API call should get a span") {
+		for i, s := range tel.spans {
+			assert.Equal("tines.ListAuditLogs", s.name, "the span should be named after the operation")
+			assert.Equal(http.MethodGet, s.attrs[tines.AttrHttpMethod])
+			assert.Equal("/api/v1/audit_logs", s.attrs[tines.AttrUrlPath])
+			assert.Equal(http.StatusOK, s.attrs[tines.AttrHttpStatusCode])
+			assert.Equal(i+1, s.attrs[tines.AttrPage], "the span should record the page number")
+			assert.Equal(0, s.attrs[tines.AttrRetryCount])
+			assert.True(s.ended, "the span should be ended")
+		}
+	}
+	if assert.Len(tel.requests, 3, "every API call should be recorded") {
+		assert.Equal("tines.ListAuditLogs", tel.requests[0].Operation)
+		assert.Empty(tel.requests[0].ErrorType)
+	}
+}
+
+func TestTelemetryError(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusNotFound, nil, []byte(`{"errors": [{"message": "not found"}]}`))
+	defer ts.Close()
+
+	tel := &testTelemetry{}
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetTelemetry(tel),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+	assert.NotNil(err)
+
+	if assert.Len(tel.spans, 1) {
+		assert.Equal("tines.GetStory", tel.spans[0].name)
+		assert.Equal(http.StatusNotFound, tel.spans[0].attrs[tines.AttrHttpStatusCode])
+		assert.ErrorIs(tel.spans[0].err, tines.ErrNotFound, "the span should record the error")
+		assert.NotContains(tel.spans[0].attrs, tines.AttrPage, "only List calls should record a page number")
+	}
+	if assert.Len(tel.requests, 1) {
+		assert.Equal(tines.ErrorTypeNotFound, tel.requests[0].ErrorType, "the error type should be recorded")
+	}
+	assert.Empty(tel.pages)
+}
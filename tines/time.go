@@ -0,0 +1,61 @@
+package tines
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// The timestamp layouts returned by the Tines API, in the order they are tried.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 MST",
+	"2006-01-02",
+}
+
+// A timestamp returned by the Tines API. It embeds a time.Time, so all of the usual time
+// methods are available directly. The null and empty values that the API returns for unset
+// timestamps decode to the zero time, which can be checked with IsZero(), and a zero Time is
+// encoded as null.
+type Time struct {
+	time.Time
+}
+
+// Implements json.Unmarshaler.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range timeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return fmt.Errorf("tines: unrecognized timestamp %q", s)
+}
+
+// Implements json.Marshaler.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(time.RFC3339Nano))
+}
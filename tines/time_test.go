@@ -0,0 +1,60 @@
+package tines_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+func TestTimeUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	var v struct {
+		Set     tines.Time `json:"set"`
+		Rails   tines.Time `json:"rails"`
+		Null    tines.Time `json:"null"`
+		Empty   tines.Time `json:"empty"`
+		Missing tines.Time `json:"missing"`
+	}
+
+	err := json.Unmarshal([]byte(`{
+		"set": "2025-06-02T03:04:05.678Z",
+		"rails": "2025-06-02 03:04:05 +0000",
+		"null": null,
+		"empty": ""
+	}`), &v)
+
+	assert.Nil(err, "the timestamps should be parsed successfully")
+	assert.True(v.Set.Equal(time.Date(2025, 6, 2, 3, 4, 5, 678000000, time.UTC)), "an RFC 3339 timestamp should be parsed")
+	assert.True(v.Rails.Equal(time.Date(2025, 6, 2, 3, 4, 5, 0, time.UTC)), "a Rails-formatted timestamp should be parsed")
+	assert.True(v.Null.IsZero(), "a null timestamp should be the zero time")
+	assert.True(v.Empty.IsZero(), "an empty timestamp should be the zero time")
+	assert.True(v.Missing.IsZero(), "a missing timestamp should be the zero time")
+
+	err = json.Unmarshal([]byte(`{"set": "yesterday"}`), &v)
+	assert.NotNil(err, "an unrecognized timestamp should fail to parse")
+}
+
+func TestTimeMarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	data, err := json.Marshal(tines.Time{Time: time.Date(2025, 6, 2, 3, 4, 5, 0, time.UTC)})
+	assert.Nil(err)
+	assert.Equal(`"2025-06-02T03:04:05Z"`, string(data), "a timestamp should be encoded in RFC 3339 format")
+
+	data, err = json.Marshal(tines.Time{})
+	assert.Nil(err)
+	assert.Equal(`null`, string(data), "the zero time should be encoded as null")
+
+	data, err = json.Marshal(tines.Credential{Name: "foo"})
+	assert.Nil(err)
+	assert.NotContains(string(data), "created_at", "unset timestamps should be omitted from request bodies")
+
+	data, err = json.Marshal(tines.Story{Name: "foo"})
+	assert.Nil(err)
+	assert.NotContains(string(data), "null", "unset timestamps should never be sent as null")
+	assert.NotContains(string(data), "edited_at", "unset timestamps should be omitted from request bodies")
+}
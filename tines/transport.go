@@ -0,0 +1,127 @@
+package tines
+
+import (
+	"net/http"
+)
+
+// A Middleware wraps the http.RoundTripper that sends every request made by a Client, including
+// webhook requests. It may modify the request before passing it to `next`, inspect or replace
+// the response, or skip `next` entirely.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// An adapter that allows an ordinary function to be used as an http.RoundTripper.
+type RoundTripperFunc func(*http.Request) (*http.Response, error)
+
+// Implements http.RoundTripper.
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// You may optionally pass a custom HTTP client when creating a new client, for example to set a
+// timeout or to route traffic through a proxy. The client passed in is never modified.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetHttpClient(&http.Client{Timeout: 30 * time.Second}),
+//	)
+func SetHttpClient(hc *http.Client) func(*Client) {
+	return func(c *Client) {
+		if hc != nil {
+			c.httpClient = hc
+		}
+	}
+}
+
+// You may optionally pass a custom transport when creating a new client, for example one that
+// trusts a corporate CA. It replaces the transport of the HTTP client set with SetHttpClient().
+//
+// Example Usage:
+//
+//	transport := http.DefaultTransport.(*http.Transport).Clone()
+//	transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetTransport(transport),
+//	)
+func SetTransport(rt http.RoundTripper) func(*Client) {
+	return func(c *Client) {
+		c.transport = rt
+	}
+}
+
+// Add middleware to the client's transport. Middleware runs in the order it is added: the first
+// one sees each request first and each response last. This option may be passed more than once.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetMiddleware(
+//	    tines.MutateRequest(func(r *http.Request) {
+//	      r.Header.Set("X-Trace-Id", traceID)
+//	    }),
+//	    tines.InspectResponse(func(r *http.Request, resp *http.Response, err error) {
+//	      log.Println(r.Method, r.URL, resp.StatusCode)
+//	    }),
+//	  ),
+//	)
+func SetMiddleware(mw ...Middleware) func(*Client) {
+	return func(c *Client) {
+		c.middleware = append(c.middleware, mw...)
+	}
+}
+
+// Returns a Middleware that calls `fn` on a copy of each outgoing request before it is sent.
+func MutateRequest(fn func(*http.Request)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			// A RoundTripper must not modify the request it is given.
+			req = req.Clone(req.Context())
+			fn(req)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// Returns a Middleware that calls `fn` with each request and the response or error it produced.
+// The response is nil if the request failed in transit. An inspector that reads the response
+// body must replace it with an unread copy.
+func InspectResponse(fn func(*http.Request, *http.Response, error)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := next.RoundTrip(req)
+			fn(req, resp, err)
+			return resp, err
+		})
+	}
+}
+
+// Build the HTTP client used for every request from the configured client, transport, and
+// middleware.
+func (c *Client) buildHttpClient() {
+	if c.transport == nil && len(c.middleware) == 0 {
+		return
+	}
+
+	hc := *c.httpClient
+	if c.transport != nil {
+		hc.Transport = c.transport
+	}
+
+	rt := hc.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		rt = c.middleware[i](rt)
+	}
+	hc.Transport = rt
+
+	c.httpClient = &hc
+}
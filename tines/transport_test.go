@@ -0,0 +1,85 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+func TestSetTransport(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	calls := 0
+	transport := tines.RoundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls++
+		return http.DefaultTransport.RoundTrip(r)
+	})
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetHttpClient(&http.Client{}),
+		tines.SetTransport(transport),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+
+	assert.Nil(err, "the story should be retrieved successfully")
+	assert.Equal(1, calls, "requests should be sent through the custom transport")
+}
+
+func TestSetMiddleware(t *testing.T) {
+	assert := assert.New(t)
+
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	var order []string
+	var traceHeader string
+	var status int
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetMiddleware(
+			tines.MutateRequest(func(r *http.Request) {
+				order = append(order, "first")
+				r.Header.Set("X-Trace-Id", "abc123")
+			}),
+			tines.InspectResponse(func(r *http.Request, resp *http.Response, err error) {
+				order = append(order, "inspect")
+				traceHeader = r.Header.Get("X-Trace-Id")
+				if resp != nil {
+					status = resp.StatusCode
+				}
+			}),
+		),
+		tines.SetMiddleware(
+			tines.MutateRequest(func(r *http.Request) {
+				order = append(order, "second")
+			}),
+		),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+
+	assert.Nil(err, "the story should be retrieved successfully")
+	assert.Equal([]string{"first", "second", "inspect"}, order, "middleware should run in the order it was added")
+	assert.Equal("abc123", traceHeader, "request mutations should be visible to later middleware")
+	assert.Equal(http.StatusOK, status, "response inspectors should see the response")
+}
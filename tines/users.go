@@ -0,0 +1,224 @@
+package tines
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+
+	"github.com/tines/go-sdk/internal/paginate"
+)
+
+type User struct {
+	ID int `json:"id,omitempty"`
+	// Required field to create a new User.
+	Email              string `json:"email,omitempty"`
+	FirstName          string `json:"first_name,omitempty"`
+	LastName           string `json:"last_name,omitempty"`
+	Admin              bool   `json:"admin,omitempty"`
+	IsActive           bool   `json:"is_active,omitempty"`
+	InvitationAccepted bool   `json:"invitation_accepted,omitempty"`
+	CreatedAt          Time   `json:"created_at,omitzero"`
+	UpdatedAt          Time   `json:"updated_at,omitzero"`
+	LastSeen           Time   `json:"last_seen,omitzero"`
+}
+
+type UserList struct {
+	Users []User        `json:"admin/users,omitempty"`
+	Meta  paginate.Meta `json:"meta,omitempty"`
+}
+
+type UserSignInActivity struct {
+	SignInAt  Time   `json:"sign_in_at,omitzero"`
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+}
+
+type UserSignInActivityList struct {
+	SignInActivities []UserSignInActivity `json:"admin/user_signin_activities,omitempty"`
+	Meta             paginate.Meta        `json:"meta,omitempty"`
+}
+
+// Create a new User and send them an invitation to the tenant. Email is a required
+// parameter. This endpoint requires an admin API key.
+func (c *Client) CreateUser(ctx context.Context, u *User) (*User, error) {
+	ctx = withOperation(ctx, "CreateUser")
+	resource := "/api/v1/admin/users"
+	errs := Error{Type: ErrorTypeRequest}
+	newUser := User{}
+
+	if u.Email == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "User Email must not be empty",
+		})
+	}
+
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	req, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(ctx, http.MethodPost, resource, nil, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &newUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &newUser, nil
+}
+
+// Get a User by unique ID. This endpoint requires an admin API key.
+func (c *Client) GetUser(ctx context.Context, id int) (*User, error) {
+	ctx = withOperation(ctx, "GetUser")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d", id)
+	user := User{}
+
+	body, err := c.doRequest(ctx, http.MethodGet, resource, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// Update a User by unique ID. Only the non-empty fields of the provided User are sent to the
+// API, so Admin and IsActive can only be set to true here. To revoke admin access, use
+// SetUserAdmin(), and to deactivate a User, use DeactivateUser(). This endpoint requires an
+// admin API key.
+func (c *Client) UpdateUser(ctx context.Context, id int, values *User) (*User, error) {
+	ctx = withOperation(ctx, "UpdateUser")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d", id)
+
+	req, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserUpdate(ctx, resource, req)
+}
+
+// Deactivate a User by unique ID. Deactivated users can no longer sign in, but their
+// account and audit history are preserved. This endpoint requires an admin API key.
+func (c *Client) DeactivateUser(ctx context.Context, id int) (*User, error) {
+	ctx = withOperation(ctx, "DeactivateUser")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d", id)
+
+	req, err := json.Marshal(map[string]bool{"is_active": false})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserUpdate(ctx, resource, req)
+}
+
+// Reactivate a previously deactivated User by unique ID. This endpoint requires an admin API
+// key.
+func (c *Client) ReactivateUser(ctx context.Context, id int) (*User, error) {
+	ctx = withOperation(ctx, "ReactivateUser")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d", id)
+
+	req, err := json.Marshal(map[string]bool{"is_active": true})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserUpdate(ctx, resource, req)
+}
+
+// Grant or revoke tenant admin access for a User by unique ID. This endpoint requires an admin
+// API key.
+func (c *Client) SetUserAdmin(ctx context.Context, id int, admin bool) (*User, error) {
+	ctx = withOperation(ctx, "SetUserAdmin")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d", id)
+
+	req, err := json.Marshal(map[string]bool{"admin": admin})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doUserUpdate(ctx, resource, req)
+}
+
+// Yields an iterator that returns individual Users. If no other filters are specified,
+// ListUsers() will recurse through all pages of results until no more are available. If
+// `filters.WithMaxResults()` is set, this function will yield either the actual set of
+// results or the specified maximum number of results, whichever is less. This endpoint
+// requires an admin API key.
+//
+// Example Usage:
+//
+//	for u, err := range ListUsers(ctx, NewListFilter()) {
+//		if err != nil {
+//			...
+//		}
+//		fmt.Println(u.Email)
+//	}
+func (c *Client) ListUsers(ctx context.Context, f ListFilter) iter.Seq2[User, error] {
+	ctx = withOperation(ctx, "ListUsers")
+	resource := "/api/v1/admin/users"
+
+	return newList[User](c, resource, "admin/users", f).All(ctx)
+}
+
+// Delete a User by unique ID. This endpoint requires an admin API key.
+func (c *Client) DeleteUser(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "DeleteUser")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d", id)
+
+	_, err := c.doRequest(ctx, http.MethodDelete, resource, nil, nil)
+
+	return err
+}
+
+// Resend the tenant invitation email to a User who has not yet accepted it. This endpoint
+// requires an admin API key.
+func (c *Client) ResendUserInvite(ctx context.Context, id int) error {
+	ctx = withOperation(ctx, "ResendUserInvite")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d/resend_invitation", id)
+
+	_, err := c.doRequest(ctx, http.MethodPost, resource, nil, nil)
+
+	return err
+}
+
+// Yields an iterator that returns the sign-in history of a User, optionally filtered to
+// sign-ins before or after a given timestamp. If `filters.WithMaxResults()` is set, this
+// function will yield either the actual set of results or the specified maximum number of
+// results, whichever is less. This endpoint requires an admin API key.
+func (c *Client) ListUserSignInActivities(ctx context.Context, id int, f ListFilter) iter.Seq2[UserSignInActivity, error] {
+	ctx = withOperation(ctx, "ListUserSignInActivities")
+	resource := fmt.Sprintf("/api/v1/admin/users/%d/signin_activities", id)
+
+	return newList[UserSignInActivity](c, resource, "admin/user_signin_activities", f).All(ctx)
+}
+
+func (c *Client) doUserUpdate(ctx context.Context, resource string, data []byte) (*User, error) {
+	updatedUser := User{}
+
+	body, err := c.doRequest(ctx, http.MethodPut, resource, nil, data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &updatedUser)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updatedUser, nil
+}
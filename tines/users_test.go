@@ -0,0 +1,324 @@
+package tines_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	// Hand-written example API response.
+	testUserResp = `
+{
+    "id": 1,
+    "email": "user@example.com",
+    "first_name": "Example",
+    "last_name": "User",
+    "admin": false,
+    "is_active": true,
+    "invitation_accepted": true,
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:00:00Z",
+    "last_seen": "2025-06-02T00:00:00Z"
+}`
+	// Hand-written example API response.
+	testDeactivateUserResp = `
+{
+    "id": 1,
+    "email": "user@example.com",
+    "first_name": "Example",
+    "last_name": "User",
+    "admin": false,
+    "is_active": false,
+    "invitation_accepted": true,
+    "created_at": "2025-06-02T00:00:00Z",
+    "updated_at": "2025-06-02T00:05:00Z",
+    "last_seen": "2025-06-02T00:00:00Z"
+}`
+	// Hand-written example API response.
+	testListUsersResp = `
+{
+    "admin/users": [
+        {
+            "id": 1,
+            "email": "user@example.com",
+            "first_name": "Example",
+            "last_name": "User",
+            "admin": false,
+            "is_active": true,
+            "invitation_accepted": true,
+            "created_at": "2025-06-02T00:00:00Z",
+            "updated_at": "2025-06-02T00:00:00Z",
+            "last_seen": "2025-06-02T00:00:00Z"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/admin/users?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+	// Hand-written example API response.
+	testListUserSignInActivitiesResp = `
+{
+    "admin/user_signin_activities": [
+        {
+            "sign_in_at": "2025-06-02T00:00:00Z",
+            "ip": "1.1.1.1",
+            "user_agent": "Foo"
+        }
+    ],
+    "meta": {
+        "current_page": "https://example.tines.com/api/v1/admin/users/1/signin_activities?per_page=20&page=1",
+        "previous_page": null,
+        "next_page": null,
+        "next_page_number": null,
+        "per_page": 20,
+        "pages": 1,
+        "count": 1
+    }
+}`
+)
+
+func TestCreateUser(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/admin/users", http.StatusCreated, []byte(`{"email": "user@example.com", "first_name": "Example", "last_name": "User"}`), []byte(testUserResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	u := tines.User{
+		Email:     "user@example.com",
+		FirstName: "Example",
+		LastName:  "User",
+	}
+
+	user, err := cli.CreateUser(ctx, &u)
+
+	assert.Nil(err, "the Tines client should create a user successfully")
+	assert.Equal(1, user.ID, "the created user ID should be parsed")
+
+	_, err = cli.CreateUser(ctx, &tines.User{})
+	assert.Error(err, "the Tines client should refuse to create a user without an email")
+}
+
+func TestGetUser(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/admin/users/1", http.StatusOK, nil, []byte(testUserResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	user, err := cli.GetUser(ctx, 1)
+
+	assert.Nil(err, "the Tines client should retrieve a user successfully")
+	assert.Equal("user@example.com", user.Email, "the user email should be parsed")
+	assert.False(user.LastSeen.IsZero(), "the user last seen timestamp should be valid")
+}
+
+func TestUpdateUser(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/admin/users/1", http.StatusOK, []byte(`{"first_name": "Example"}`), []byte(testUserResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	user, err := cli.UpdateUser(ctx, 1, &tines.User{FirstName: "Example"})
+
+	assert.Nil(err, "the Tines client should update a user successfully")
+	assert.Equal("Example", user.FirstName, "the user first name should be updated")
+}
+
+func TestDeactivateUser(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/admin/users/1", http.StatusOK, []byte(`{"is_active": false}`), []byte(testDeactivateUserResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	user, err := cli.DeactivateUser(ctx, 1)
+
+	assert.Nil(err, "the Tines client should deactivate a user successfully")
+	assert.False(user.IsActive, "the user should be deactivated")
+}
+
+func TestReactivateUser(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/admin/users/1", http.StatusOK, []byte(`{"is_active": true}`), []byte(testUserResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.ReactivateUser(ctx, 1)
+
+	assert.Nil(err, "the Tines client should reactivate a user successfully")
+}
+
+func TestSetUserAdmin(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPut, "/api/v1/admin/users/1", http.StatusOK, []byte(`{"admin": false}`), []byte(testUserResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.SetUserAdmin(ctx, 1, false)
+
+	assert.Nil(err, "the Tines client should revoke admin access successfully")
+}
+
+func TestListUsers(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/admin/users", http.StatusOK, nil, []byte(testListUsersResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for u, err := range cli.ListUsers(ctx, tines.NewListFilter()) {
+		assert.Nil(err, "the list of users should be iterable")
+		assert.Equal("user@example.com", u.Email, "the user email should be retrieved successfully")
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodDelete, "/api/v1/admin/users/1", http.StatusNoContent, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.DeleteUser(ctx, 1)
+
+	assert.Nil(err, "the Tines client should delete the user successfully")
+}
+
+func TestResendUserInvite(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodPost, "/api/v1/admin/users/1/resend_invitation", http.StatusOK, nil, nil)
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	err = cli.ResendUserInvite(ctx, 1)
+
+	assert.Nil(err, "the Tines client should resend the user invite successfully")
+}
+
+func TestListUserSignInActivities(t *testing.T) {
+	assert := assert.New(t)
+	ts := createRouteTestServer(assert, http.MethodGet, "/api/v1/admin/users/1/signin_activities", http.StatusOK, nil, []byte(testListUserSignInActivitiesResp))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for a, err := range cli.ListUserSignInActivities(ctx, 1, tines.NewListFilter()) {
+		assert.Nil(err, "the list of sign-in activities should be iterable")
+		assert.Equal("1.1.1.1", a.IP, "the sign-in IP should be retrieved successfully")
+	}
+}
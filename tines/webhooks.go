@@ -0,0 +1,276 @@
+package tines
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/tines/go-sdk/internal/utils"
+)
+
+// Enum for the encoding of the payload sent to a Webhook Action.
+type WebhookBodyType string
+
+const (
+	WebhookBodyJson WebhookBodyType = "application/json"
+	WebhookBodyForm WebhookBodyType = "application/x-www-form-urlencoded"
+)
+
+type WebhookOptions struct {
+	Method   string
+	BodyType WebhookBodyType
+	Headers  http.Header
+}
+
+// The response returned by a Webhook Action. Webhooks configured with a custom response
+// can set the status code, headers, and body, so all three are returned to the caller.
+type WebhookResponse struct {
+	StatusCode int
+	Headers    http.Header
+	Body       []byte
+}
+
+// Specify the HTTP method used to call the webhook. The method must be one of the verbs
+// enabled on the Webhook Action. Defaults to POST.
+func WithWebhookMethod(m string) func(*WebhookOptions) {
+	return func(o *WebhookOptions) {
+		o.Method = strings.ToUpper(m)
+	}
+}
+
+// Send the webhook payload as a URL-encoded form instead of JSON. The payload must be a
+// url.Values, a map[string]string, or a map[string]any.
+func WithWebhookFormBody() func(*WebhookOptions) {
+	return func(o *WebhookOptions) {
+		o.BodyType = WebhookBodyForm
+	}
+}
+
+// Add a custom header to the webhook request. Headers are visible to the story, so they can
+// be used for routing or for additional verification inside the Webhook Action.
+func WithWebhookHeader(k, v string) func(*WebhookOptions) {
+	return func(o *WebhookOptions) {
+		o.Headers.Add(k, v)
+	}
+}
+
+// Unmarshal the JSON body of a webhook response into the provided value.
+func (r *WebhookResponse) Decode(v any) error {
+	err := json.Unmarshal(r.Body, v)
+	if err != nil {
+		return Error{
+			Type:       ErrorTypeServer,
+			StatusCode: r.StatusCode,
+			Errors: []ErrorMessage{
+				{
+					Message: errUnmarshalError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+	return nil
+}
+
+// Send a payload to a Webhook Action at https://<tenant>/webhook/<path>/<secret>. The path and
+// secret are the values of the `path` and `secret` options on the Webhook Action. Webhooks
+// do not use the API key, so it is never sent with the request.
+//
+// Example Usage:
+//
+//	res, err := cli.TriggerWebhook(ctx, "2a5b9cd5b43d06329fd72f70c7cbeede", "cf881382af21ef97840c36aa9391f6cc",
+//		map[string]any{"foo": "bar"},
+//		tines.WithWebhookHeader("X-Request-Source", "go-sdk"),
+//	)
+func (c *Client) TriggerWebhook(ctx context.Context, path, secret string, payload any, opts ...func(*WebhookOptions)) (*WebhookResponse, error) {
+	o := WebhookOptions{
+		Method:   http.MethodPost,
+		BodyType: WebhookBodyJson,
+		Headers:  http.Header{},
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	errs := Error{Type: ErrorTypeRequest}
+
+	if path == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Webhook path must not be empty",
+		})
+	}
+
+	if secret == "" {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Webhook secret must not be empty",
+		})
+	}
+
+	// The path and secret are joined onto the tenant URL, so they must not be able to leave
+	// the /webhook/ prefix.
+	if invalidWebhookSegment(path) {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Webhook path must not contain '/', '\\', or '..'",
+		})
+	}
+
+	if invalidWebhookSegment(secret) {
+		errs.Errors = append(errs.Errors, ErrorMessage{
+			Message: errParseError,
+			Details: "Webhook secret must not contain '/', '\\', or '..'",
+		})
+	}
+
+	if errs.HasErrors() {
+		return nil, errs
+	}
+
+	data, err := encodeWebhookPayload(payload, o.BodyType)
+	if err != nil {
+		return nil, Error{
+			Type: ErrorTypeRequest,
+			Errors: []ErrorMessage{
+				{
+					Message: errParseError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+
+	tenant, err := url.Parse(c.tenantUrl)
+	if err != nil {
+		return nil, Error{
+			Type: ErrorTypeRequest,
+			Errors: []ErrorMessage{
+				{
+					Message: errParseError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+
+	fullUrl := tenant.JoinPath("webhook", path, secret)
+
+	c.logger.Debug(fmt.Sprintf("sending webhook request to path %s", path))
+
+	req, err := http.NewRequestWithContext(ctx, o.Method, fullUrl.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, Error{
+			Type: ErrorTypeRequest,
+			Errors: []ErrorMessage{
+				{
+					Message: errDoRequestError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+
+	for k, v := range o.Headers {
+		for _, s := range v {
+			req.Header.Add(k, s)
+		}
+	}
+	req.Header.Set("Content-Type", string(o.BodyType))
+	req.Header.Set("User-Agent", utils.SetUserAgent(c.userAgent))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, Error{
+			Type: ErrorTypeRequest,
+			Errors: []ErrorMessage{
+				{
+					Message: errDoRequestError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, Error{
+			Type:       ErrorTypeServer,
+			StatusCode: resp.StatusCode,
+			Errors: []ErrorMessage{
+				{
+					Message: errReadBodyError,
+					Details: err.Error(),
+				},
+			},
+			Err: err,
+		}
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		c.logger.Debug(fmt.Sprintf("received a %d status code from the webhook", resp.StatusCode))
+
+		return nil, Error{
+			Type:       errorTypeForStatus(resp.StatusCode),
+			StatusCode: resp.StatusCode,
+			Method:     o.Method,
+			RequestID:  resp.Header.Get("X-Request-Id"),
+			Errors:     c.getErrorMessages(body),
+		}
+	}
+
+	return &WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		Body:       body,
+	}, nil
+}
+
+func encodeWebhookPayload(payload any, t WebhookBodyType) ([]byte, error) {
+	if payload == nil {
+		return nil, nil
+	}
+
+	if t == WebhookBodyForm {
+		form := url.Values{}
+		switch p := payload.(type) {
+		case url.Values:
+			form = p
+		case map[string]string:
+			for k, v := range p {
+				form.Set(k, v)
+			}
+		case map[string]any:
+			for k, v := range p {
+				form.Set(k, fmt.Sprint(v))
+			}
+		default:
+			return nil, fmt.Errorf("form payloads must be url.Values, map[string]string, or map[string]any, got %T", payload)
+		}
+		return []byte(form.Encode()), nil
+	}
+
+	// Allow callers to send pre-encoded JSON without it being re-encoded as a base64 string.
+	if b, ok := payload.([]byte); ok {
+		return b, nil
+	}
+
+	return json.Marshal(payload)
+}
+
+func invalidWebhookSegment(s string) bool {
+	return strings.ContainsAny(s, `/\`) || strings.Contains(s, "..")
+}
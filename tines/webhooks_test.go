@@ -0,0 +1,170 @@
+package tines_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+)
+
+const (
+	testWebhookPath   = "2a5b9cd5b43d06329fd72f70c7cbeede"
+	testWebhookSecret = "cf881382af21ef97840c36aa9391f6cc"
+)
+
+func TestTriggerWebhook(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPost, r.Method)
+		assert.Equal("/webhook/"+testWebhookPath+"/"+testWebhookSecret, r.URL.Path, "the webhook URL should be built from the path and secret")
+		assert.Equal("application/json", r.Header.Get("Content-Type"))
+		assert.Equal("go-sdk", r.Header.Get("X-Request-Source"), "custom headers should be sent")
+		assert.Empty(r.Header.Get("Authorization"), "the API key should never be sent to a webhook")
+
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(err, "HTTP request body should be readable")
+		assert.JSONEq(`{"foo": "bar"}`, string(body), "the payload should be sent as JSON")
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"result": "ok"}`)) //nolint:errcheck
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	res, err := cli.TriggerWebhook(ctx, testWebhookPath, testWebhookSecret,
+		map[string]any{"foo": "bar"},
+		tines.WithWebhookHeader("X-Request-Source", "go-sdk"),
+	)
+
+	assert.Nil(err, "the webhook should be triggered successfully")
+	if err != nil {
+		return
+	}
+	assert.Equal(http.StatusCreated, res.StatusCode, "the custom webhook status code should be returned")
+
+	var out struct {
+		Result string `json:"result"`
+	}
+	err = res.Decode(&out)
+	assert.Nil(err, "the webhook response should be decodable")
+	assert.Equal("ok", out.Result, "the webhook response body should be returned")
+}
+
+func TestTriggerWebhookForm(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPut, r.Method)
+		assert.Equal("application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.Nil(r.ParseForm(), "the form body should be parseable")
+		assert.Equal("bar", r.PostForm.Get("foo"), "the payload should be sent as a form")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.TriggerWebhook(ctx, testWebhookPath, testWebhookSecret,
+		map[string]string{"foo": "bar"},
+		tines.WithWebhookFormBody(),
+		tines.WithWebhookMethod("put"),
+	)
+
+	assert.Nil(err, "the webhook should be triggered successfully")
+}
+
+func TestTriggerWebhookError(t *testing.T) {
+	assert := assert.New(t)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	_, err = cli.TriggerWebhook(ctx, testWebhookPath, "wrong", nil)
+
+	var tErr tines.Error
+	assert.ErrorAs(err, &tErr, "a failed webhook call should return a Tines error")
+	assert.Equal(http.StatusNotFound, tErr.StatusCode, "the webhook status code should be preserved")
+
+	_, err = cli.TriggerWebhook(ctx, "", "", nil)
+	assert.Error(err, "the webhook path and secret should be required")
+}
+
+func TestTriggerWebhookInvalidPath(t *testing.T) {
+	assert := assert.New(t)
+	called := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer ts.Close()
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	cases := []struct {
+		path   string
+		secret string
+	}{
+		{"../api/v1/stories", testWebhookSecret},
+		{"..", testWebhookSecret},
+		{testWebhookPath, "foo/bar"},
+		{testWebhookPath, `foo\bar`},
+	}
+
+	for _, c := range cases {
+		_, err = cli.TriggerWebhook(ctx, c.path, c.secret, map[string]any{})
+
+		var tErr tines.Error
+		if assert.ErrorAs(err, &tErr, "a webhook path or secret that escapes /webhook/ should be rejected") {
+			assert.Equal(tines.ErrorTypeRequest, tErr.Type)
+		}
+	}
+
+	assert.False(called, "an invalid webhook path or secret should not be sent to the server")
+}
@@ -0,0 +1,190 @@
+package tines
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tines/go-sdk/internal/logging"
+)
+
+const (
+	redactedValue      = "[REDACTED]"
+	maxLoggedBodyBytes = 4096
+)
+
+// Headers that are always redacted from wire logs.
+var redactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// JSON fields are redacted from wire logs, wherever they appear in a request or response body,
+// if their name contains any of these strings. This covers every secret in CredentialPayload, as
+// well as OAuth tokens and secrets returned by the API.
+var redactedFieldParts = []string{
+	"secret",
+	"token",
+	"password",
+	"private_key",
+	"access_key",
+	"api_key",
+	"authorization",
+	"cookie",
+}
+
+// JSON fields that are redacted by exact name. The "value" field of a text Credential shares its
+// name with the value of a Resource, so Resource values are redacted too.
+var redactedFields = map[string]bool{
+	"value": true,
+}
+
+// JSON objects that are redacted in full, because they hold arbitrary headers and payloads that
+// may carry secrets under any name.
+var redactedObjects = map[string]bool{
+	"headers":              true,
+	"http_request_options": true,
+}
+
+// You may optionally enable wire logging when creating a new client. Every HTTP request and
+// response is then logged at a DEBUG level with the method, URL, status code, latency, headers,
+// and bodies as structured fields. The Authorization header, Credential values, any JSON field
+// whose name looks like a secret, token, or password, and any nested header maps are redacted
+// automatically, and bodies are truncated to 4KB. Wire logging is intended for debugging and is
+// off by default.
+//
+// Example Usage:
+//
+//	client, err := tines.NewClient(
+//	  tines.SetTenantUrl("https://example.tines.com/"),
+//	  tines.SetApiKey("foobar"),
+//	  tines.SetLogger(logger),
+//	  tines.SetWireLogging(true),
+//	)
+func SetWireLogging(enabled bool) func(*Client) {
+	return func(c *Client) {
+		c.wireLogging = enabled
+	}
+}
+
+func (c *Client) logWire(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, latency time.Duration, err error) {
+	if !c.wireLogging {
+		return
+	}
+
+	fields := []logging.Field{
+		logging.Any("method", req.Method),
+		logging.Any("url", req.URL.String()),
+		logging.Any("latency", latency),
+		logging.Any("request_headers", redactHeaders(req.Header)),
+	}
+
+	if len(reqBody) > 0 {
+		fields = append(fields, logging.Any("request_body", redactBody(reqBody)))
+	}
+
+	if resp != nil {
+		fields = append(fields,
+			logging.Any("status", resp.StatusCode),
+			logging.Any("response_headers", redactHeaders(resp.Header)),
+		)
+	}
+
+	if len(respBody) > 0 {
+		fields = append(fields, logging.Any("response_body", redactBody(respBody)))
+	}
+
+	if err != nil {
+		fields = append(fields, logging.Err(err))
+	}
+
+	c.logger.Debug("tines api request", fields...)
+}
+
+func redactHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range redactedHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redactedValue)
+		}
+	}
+	return h
+}
+
+// Redact known secret fields from a JSON body. Bodies that aren't valid JSON can't be inspected,
+// so only their size is logged.
+func redactBody(body []byte) string {
+	var v any
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return fmt.Sprintf("[%d bytes of non-JSON data]", len(body))
+	}
+
+	data, err := json.Marshal(redactValue(v))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of unloggable data]", len(body))
+	}
+
+	if len(data) > maxLoggedBodyBytes {
+		return string(data[:maxLoggedBodyBytes]) + "...[truncated]"
+	}
+	return string(data)
+}
+
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if redactedKey(k) {
+				v[k] = redactedValue
+			} else if strings.EqualFold(k, "credential_requests") {
+				v[k] = redactCredentialRequests(e)
+			} else {
+				v[k] = redactValue(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	}
+	return v
+}
+
+func redactedKey(k string) bool {
+	k = strings.ToLower(k)
+	if redactedFields[k] || redactedObjects[k] {
+		return true
+	}
+
+	for _, part := range redactedFieldParts {
+		if strings.Contains(k, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// The options of each request made by a multi-request Credential hold arbitrary payloads, so
+// they are redacted in full.
+func redactCredentialRequests(v any) any {
+	reqs, ok := v.([]any)
+	if !ok {
+		return redactValue(v)
+	}
+
+	for _, r := range reqs {
+		if m, ok := r.(map[string]any); ok {
+			if _, ok := m["options"]; ok {
+				m["options"] = redactedValue
+			}
+		}
+	}
+	return redactValue(reqs)
+}
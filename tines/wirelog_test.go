@@ -0,0 +1,176 @@
+package tines_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tines/go-sdk/tines"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestWireLogging(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusCreated, nil, []byte(`{"id": 1, "name": "Test", "mode": "TEXT", "value": "top-secret-response"}`))
+	defer ts.Close()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetLogger(zap.New(core)),
+		tines.SetWireLogging(true),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	cred := tines.Credential{
+		Name:   "Test",
+		Mode:   tines.CredentialTypeText,
+		TeamId: 1,
+		CredentialPayload: tines.CredentialPayload{
+			TextValue:         "top-secret-request",
+			OauthClientSecret: "top-secret-oauth",
+		},
+	}
+
+	_, err = cli.CreateCredential(context.Background(), &cred)
+	assert.Nil(err, "the credential should be created successfully")
+
+	entries := logs.FilterMessage("tines api request").All()
+	if !assert.Len(entries, 1, "each HTTP request should be logged once") {
+		return
+	}
+
+	fields := entries[0].ContextMap()
+	assert.Equal(http.MethodPost, fields["method"])
+	assert.Equal(ts.URL+"/api/v1/user_credentials", fields["url"])
+	assert.EqualValues(http.StatusCreated, fields["status"])
+	assert.Contains(fields, "latency")
+
+	reqBody, _ := fields["request_body"].(string)
+	assert.Contains(reqBody, `"name":"Test"`, "non-secret fields should be logged")
+	assert.Contains(reqBody, "[REDACTED]", "secret fields should be redacted")
+
+	for _, e := range logs.All() {
+		for k, v := range e.ContextMap() {
+			s := fmt.Sprint(v)
+			assert.NotContains(s, "top-secret", "secrets should never be logged (field %s)", k)
+			assert.NotContains(s, "Bearer foo", "the API key should never be logged (field %s)", k)
+		}
+	}
+}
+
+func TestWireLoggingRequestCredentials(t *testing.T) {
+	assert := assert.New(t)
+
+	multi := tines.CredentialMultiReq{Secret: "top-secret-multi"}
+	multi.Options.Url = "https://example.com/token"
+	multi.Options.Headers = map[string]any{"X-Api-Secret": "top-secret-multi-header"}
+	multi.Options.Payload = map[string]any{"code": "top-secret-multi-payload"}
+
+	tests := []struct {
+		name    string
+		payload tines.CredentialPayload
+		mode    tines.CredentialType
+	}{
+		{"HttpRequest", tines.CredentialPayload{
+			HttpReqOpts: map[string]any{
+				"url":     "https://example.com/oauth/token",
+				"method":  "POST",
+				"headers": map[string]any{"Authorization": "Bearer top-secret-header", "X-Custom": "top-secret-custom"},
+				"payload": map[string]any{"client_secret": "top-secret-payload", "grant_type": "client_credentials"},
+			},
+			HttpReqSecret: "top-secret-http",
+		}, tines.CredentialTypeHttp},
+		{"MultiRequest", tines.CredentialPayload{
+			MultiCredReqs: []tines.CredentialMultiReq{multi},
+		}, tines.CredentialTypeMulti},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ts := createTestServer(assert, http.StatusCreated, nil, []byte(`{
+				"id": 1,
+				"name": "Test",
+				"mode": "`+string(test.mode)+`",
+				"access_token": "top-secret-access",
+				"refresh_token": "top-secret-refresh",
+				"client_secret": "top-secret-client",
+				"jwt": {"private_key": "top-secret-key"}
+			}`))
+			defer ts.Close()
+
+			core, logs := observer.New(zapcore.DebugLevel)
+
+			cli, err := tines.NewClient(
+				tines.SetApiKey("foo"),
+				tines.SetTenantUrl(ts.URL),
+				tines.SetLogger(zap.New(core)),
+				tines.SetWireLogging(true),
+			)
+
+			assert.Nil(err, "the Tines CLI client should instantiate successfully")
+			if err != nil {
+				return
+			}
+
+			cred := tines.Credential{
+				Name:              "Test",
+				Mode:              test.mode,
+				TeamId:            1,
+				CredentialPayload: test.payload,
+			}
+
+			_, err = cli.CreateCredential(context.Background(), &cred)
+			assert.Nil(err, "the credential should be created successfully")
+
+			entries := logs.FilterMessage("tines api request").All()
+			if !assert.Len(entries, 1, "each HTTP request should be logged once") {
+				return
+			}
+
+			fields := entries[0].ContextMap()
+			assert.Contains(fields["request_body"], `"name":"Test"`, "non-secret fields should be logged")
+			assert.Contains(fields["response_body"], `"id":1`, "non-secret fields should be logged")
+
+			for _, e := range logs.All() {
+				for k, v := range e.ContextMap() {
+					assert.NotContains(fmt.Sprint(v), "top-secret", "secrets should never be logged (field %s)", k)
+				}
+			}
+		})
+	}
+}
+
+func TestWireLoggingDisabled(t *testing.T) {
+	assert := assert.New(t)
+	ts := createTestServer(assert, http.StatusOK, nil, []byte(testStoryResp))
+	defer ts.Close()
+
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	cli, err := tines.NewClient(
+		tines.SetApiKey("foo"),
+		tines.SetTenantUrl(ts.URL),
+		tines.SetLogger(zap.New(core)),
+	)
+
+	assert.Nil(err, "the Tines CLI client should instantiate successfully")
+	if err != nil {
+		return
+	}
+
+	_, err = cli.GetStory(context.Background(), 1)
+	assert.Nil(err)
+
+	assert.Zero(logs.FilterMessage("tines api request").Len(), "wire logging should be off by default")
+}